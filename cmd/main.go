@@ -25,7 +25,10 @@ import (
 	"os"
 	"runtime"
 	"runtime/pprof"
+	"strings"
+	"time"
 
+	"github.com/datacommonsorg/mixer/internal/merger"
 	pbs "github.com/datacommonsorg/mixer/internal/proto/service"
 	"github.com/datacommonsorg/mixer/internal/server"
 	"github.com/datacommonsorg/mixer/internal/server/cache"
@@ -33,10 +36,10 @@ import (
 	"github.com/datacommonsorg/mixer/internal/server/datasources"
 	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
 	"github.com/datacommonsorg/mixer/internal/server/healthcheck"
+	"github.com/datacommonsorg/mixer/internal/server/lru"
 	"github.com/datacommonsorg/mixer/internal/server/redis"
-	"github.com/datacommonsorg/mixer/internal/server/remote"
 	"github.com/datacommonsorg/mixer/internal/server/spanner"
-	"github.com/datacommonsorg/mixer/internal/server/v3/observation"
+	"github.com/datacommonsorg/mixer/internal/server/v3/config"
 	"github.com/datacommonsorg/mixer/internal/sqldb"
 	"github.com/datacommonsorg/mixer/internal/store"
 	"github.com/datacommonsorg/mixer/internal/store/bigtable"
@@ -94,11 +97,25 @@ var (
 	// Spanner Graph
 	useSpannerGraph  = flag.Bool("use_spanner_graph", false, "Use Google Spanner as a database.")
 	spannerGraphInfo = flag.String("spanner_graph_info", "", "Yaml formatted text containing information for Spanner Graph.")
+	// In-memory data source.
+	useMockDataSource = flag.Bool("use_mock_data_source", false, "Use an in-memory data source loaded from files in V3 API.")
+	mockDataPaths     = flag.String("mock_data_paths", "", "Comma separated MCF and CSV (triples or observations) files to load into the in-memory data source.")
 	// Redis.
 	useRedis  = flag.Bool("use_redis", false, "Use Redis cache.")
 	redisInfo = flag.String("redis_info", "", "Yaml formatted text containing information for redis instances.")
+	// In-memory cache.
+	lruCacheMB  = flag.Int64("lru_cache_mb", 0, "Size in MB of the in-memory cache of V3 responses, checked before Redis. Disabled if 0.")
+	lruCacheTTL = flag.Duration("lru_cache_ttl", time.Hour, "Time to live of responses in the in-memory cache of V3 responses.")
+	// Request coalescing.
+	v3CoalesceRequests = flag.Bool("v3_coalesce_requests", false, "Handle identical concurrent V3 requests once and share the response.")
 	// V3 API.
-	enableV3 = flag.Bool("enable_v3", false, "Enable datasources in V3 API.")
+	enableV3            = flag.Bool("enable_v3", false, "Enable datasources in V3 API.")
+	v3BestEffortSources = flag.String("v3_best_effort_sources", "", "Comma separated data source ids (e.g. remote-api.datacommons.org) whose failures don't fail V3 requests.")
+	v3SourceTimeouts    = flag.String("v3_source_timeouts", "", "Comma separated data source id timeouts for V3 requests, e.g. remote-api.datacommons.org=2s.")
+	v3SourcePriorities  = flag.String("v3_source_priorities", "", "Comma separated data source id priorities for merging V3 responses, e.g. remote-api.datacommons.org=-1. Higher priorities are preferred.")
+	v3SourcePrefixes    = flag.String("v3_source_prefixes", "", "Comma separated data source id and DCID prefix pairs for routing V3 requests, e.g. sql-sqlite3-/data/datacommons.db=myorg/. DCIDs with a prefix are only sent to that source.")
+	v3MergePolicy       = flag.String("v3_merge_policy", "union", "Policy for merging V3 responses: union or first_non_empty. Use first_non_empty with the highest sql priority to let custom data win.")
+	v3ConfigPath        = flag.String("v3_config", "", "Path to a YAML file that configures the V3 data sources and processors. If set, it replaces the other V3 flags.")
 )
 
 func main() {
@@ -125,18 +142,18 @@ func main() {
 	// Create grpc server.
 	srv := grpc.NewServer()
 
-	// Data sources.
-	sources := []*datasource.DataSource{}
-
-	// Spanner Graph.
-	if *enableV3 && *useSpannerGraph {
-		spannerClient, err := spanner.NewSpannerClient(ctx, *spannerGraphInfo)
+	// V3 config.
+	var v3Config *config.Config
+	if *v3ConfigPath != "" {
+		v3Config, err = config.Load(*v3ConfigPath)
+		if err != nil {
+			log.Fatalf("Failed to load V3 config: %v", err)
+		}
+	} else if *enableV3 {
+		v3Config, err = v3ConfigFromFlags()
 		if err != nil {
-			log.Fatalf("Failed to create Spanner client: %v", err)
+			log.Fatalf("Failed to create V3 config from flags: %v", err)
 		}
-		var ds datasource.DataSource = spanner.NewSpannerDataSource(spannerClient)
-		// TODO: Order sources by priority once other implementations are added.
-		sources = append(sources, &ds)
 	}
 
 	// Bigtable cache
@@ -210,18 +227,6 @@ func main() {
 		log.Fatalf("Failed to create metadata: %v", err)
 	}
 
-	// Remote Mixer.
-	// Create remote data source here but don't add it to sources yet since we want it to be the last source added.
-	// TODO: clean up how we create and add data sources.
-	var remoteDataSource datasource.DataSource
-	if *enableV3 && *remoteMixerDomain != "" {
-		remoteClient, err := remote.NewRemoteClient(metadata)
-		if err != nil {
-			log.Fatalf("Failed to create remote client: %v", err)
-		}
-		remoteDataSource = remote.NewRemoteDataSource(remoteClient)
-	}
-
 	// SQL client
 	var sqlClient sqldb.SQLClient
 	if *useSQLite {
@@ -254,15 +259,9 @@ func main() {
 		}
 	}
 
-	// SQL Data Source
-	if *enableV3 && sqldb.IsConnected(&sqlClient) {
-		var ds datasource.DataSource = sqldb.NewSQLDataSource(&sqlClient, remoteDataSource)
-		sources = append(sources, &ds)
-	}
-
 	// Store
-	if len(tables) == 0 && *remoteMixerDomain == "" && !sqldb.IsConnected(&sqlClient) {
-		log.Fatal("No bigtables or remote mixer domain or sql database are provided")
+	if len(tables) == 0 && *remoteMixerDomain == "" && !sqldb.IsConnected(&sqlClient) && v3Config == nil {
+		log.Fatal("No bigtables or remote mixer domain or sql database or V3 data sources are provided")
 	}
 	store, err := store.NewStore(
 		bqClient, sqlClient, tables, branchTableName, metadata)
@@ -292,41 +291,36 @@ func main() {
 		}
 	}
 
-	// Add remote data source if it was created.
-	if remoteDataSource != nil {
-		sources = append(sources, &remoteDataSource)
-	}
-
-	// DataSources
-	dataSources := datasources.NewDataSources(sources)
-
-	// Processors
-	processors := []*dispatcher.Processor{}
-	if *enableV3 {
-		// Cache Processor
-		if *useRedis && *redisInfo != "" {
-			redisClient, err := redis.NewCacheClient(*redisInfo)
-			if err != nil {
-				log.Fatalf("Failed to create Redis client: %v", err)
-			}
-			defer redisClient.Close()
-
-			var redisProcessor dispatcher.Processor = redis.NewCacheProcessor(redisClient)
-			processors = append(processors, &redisProcessor)
+	// Dispatcher
+	dispatcher := dispatcher.NewDispatcher(nil, datasources.NewDataSources(nil, nil, merger.MergePolicyUnion, nil))
+	if v3Config != nil {
+		v3Dispatcher, closeV3, err := config.NewDispatcher(ctx, v3Config, &config.Dependencies{
+			Metadata:  metadata,
+			SQLClient: &sqlClient,
+			Cache:     c,
+		})
+		if err != nil {
+			log.Fatalf("Failed to create V3 dispatcher: %v", err)
 		}
-
-		// Calculation Processor
-		var calculationProcessor dispatcher.Processor = observation.NewCalculationProcessor(dataSources, c.SVFormula())
-		processors = append(processors, &calculationProcessor)
+		defer closeV3()
+		dispatcher = v3Dispatcher
 	}
 
-	// Dispatcher
-	dispatcher := dispatcher.NewDispatcher(processors, dataSources)
-
 	// Create server object
 	mixerServer := server.NewMixerServer(store, metadata, c, mapsClient, dispatcher)
 	pbs.RegisterMixerServer(srv, mixerServer)
 
+	// Namespace cached V3 responses by the data they're computed from.
+	if v3Config != nil {
+		configHash, err := v3Config.Hash()
+		if err != nil {
+			log.Fatalf("Failed to hash V3 config: %v", err)
+		}
+		if err := mixerServer.InitDataVersion(ctx, configHash); err != nil {
+			log.Fatalf("Failed to initialize the data version of cached responses: %v", err)
+		}
+	}
+
 	// Subscribe to branch cache update
 	if *useBranchBigtable {
 		err := mixerServer.SubscribeBranchCacheUpdate(ctx)
@@ -377,3 +371,65 @@ func main() {
 		log.Fatalf("Failed to serve: %v", err)
 	}
 }
+
+// v3ConfigFromFlags creates the V3 config from the V3 flags.
+// Sources are ordered as spanner, mock, sql and remote. Requests are coalesced before the cache processors,
+// which run before the normalization, aggregation and calculation processors. Processors post-process responses
+// in reverse, so places are aggregated after calculations and observations are normalized last.
+// It returns nil if no sources are enabled.
+func v3ConfigFromFlags() (*config.Config, error) {
+	policies, err := datasources.ParseSourcePolicies(*v3BestEffortSources, *v3SourceTimeouts, *v3SourcePriorities)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse data source policies: %w", err)
+	}
+	prefixes, err := datasources.ParseRoutingPrefixes(*v3SourcePrefixes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse data source prefixes: %w", err)
+	}
+
+	cfg := &config.Config{MergePolicy: *v3MergePolicy, SourcePolicies: policies, SourcePrefixes: prefixes}
+
+	if *useSpannerGraph {
+		spannerConfig, err := spanner.ParseSpannerConfig(*spannerGraphInfo)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Sources = append(cfg.Sources, &config.SourceConfig{Type: datasource.TypeSpanner, Spanner: spannerConfig})
+	}
+	if *useMockDataSource {
+		cfg.Sources = append(cfg.Sources, &config.SourceConfig{Type: datasource.TypeMock, Paths: strings.Split(*mockDataPaths, ",")})
+	}
+	if *useSQLite || *useCloudSQL {
+		cfg.Sources = append(cfg.Sources, &config.SourceConfig{Type: datasource.TypeSQL})
+	}
+	if *remoteMixerDomain != "" {
+		cfg.Sources = append(cfg.Sources, &config.SourceConfig{Type: datasource.TypeRemote})
+	}
+	if len(cfg.Sources) == 0 {
+		return nil, nil
+	}
+
+	if *v3CoalesceRequests {
+		cfg.Processors = append(cfg.Processors, &config.ProcessorConfig{Type: config.ProcessorSingleflight})
+	}
+	if *lruCacheMB > 0 {
+		cfg.Processors = append(cfg.Processors, &config.ProcessorConfig{
+			Type: config.ProcessorLRUCache,
+			LRU:  &lru.Config{MaxBytes: *lruCacheMB << 20, TTL: *lruCacheTTL},
+		})
+	}
+	if *useRedis && *redisInfo != "" {
+		redisConfig, err := redis.ParseRedisConfig(*redisInfo)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Processors = append(cfg.Processors, &config.ProcessorConfig{Type: config.ProcessorCache, Redis: redisConfig})
+	}
+	cfg.Processors = append(cfg.Processors,
+		&config.ProcessorConfig{Type: config.ProcessorNormalization},
+		&config.ProcessorConfig{Type: config.ProcessorAggregation},
+		&config.ProcessorConfig{Type: config.ProcessorCalculation},
+	)
+
+	return cfg, cfg.Validate()
+}
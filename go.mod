@@ -15,6 +15,7 @@ require (
 	github.com/golang/geo v0.0.0-20230421003525-6adc56603217
 	github.com/google/go-cmp v0.5.9
 	github.com/google/pprof v0.0.0-20240409012703-83162a5b38cd
+	github.com/jmoiron/sqlx v1.4.0
 	golang.org/x/oauth2 v0.12.0
 	golang.org/x/sync v0.6.0
 	golang.org/x/text v0.14.0
@@ -31,7 +32,6 @@ require (
 	filippo.io/edwards25519 v1.1.0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
-	github.com/jmoiron/sqlx v1.4.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 )
 
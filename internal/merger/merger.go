@@ -20,12 +20,15 @@
 package merger
 
 import (
+	"fmt"
 	"sort"
+	"strings"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	pbv1 "github.com/datacommonsorg/mixer/internal/proto/v1"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
 	"github.com/datacommonsorg/mixer/internal/server/pagination"
+	"github.com/datacommonsorg/mixer/internal/translator/types"
 	"github.com/datacommonsorg/mixer/internal/util"
 )
 
@@ -35,6 +38,10 @@ const (
 
 // MergeResolve merges two V2 resolve responses.
 func MergeResolve(main, aux *pbv2.ResolveResponse) *pbv2.ResolveResponse {
+	return mergeResolve(main, aux, MergePolicyUnion)
+}
+
+func mergeResolve(main, aux *pbv2.ResolveResponse, policy MergePolicy) *pbv2.ResolveResponse {
 	if main == nil {
 		return aux
 	}
@@ -51,6 +58,10 @@ func MergeResolve(main, aux *pbv2.ResolveResponse) *pbv2.ResolveResponse {
 	for _, e := range main.GetEntities() {
 		node := e.Node
 		if auxEntity, ok := auxStore[node]; ok {
+			delete(auxStore, node)
+			if policy == MergePolicyFirstNonEmpty && len(e.Candidates) > 0 {
+				continue
+			}
 			existCandidates := map[string]struct{}{}
 			for _, c := range e.Candidates {
 				existCandidates[c.Dcid] = struct{}{}
@@ -60,7 +71,6 @@ func MergeResolve(main, aux *pbv2.ResolveResponse) *pbv2.ResolveResponse {
 					e.Candidates = append(e.Candidates, c)
 				}
 			}
-			delete(auxStore, node)
 		}
 	}
 	// Add aux entities that are not in main
@@ -75,12 +85,18 @@ func MergeResolve(main, aux *pbv2.ResolveResponse) *pbv2.ResolveResponse {
 // Merges multiple V2 ResolveResponses.
 // Assumes the responses are in order of priority.
 func MergeMultiResolve(allResp []*pbv2.ResolveResponse) *pbv2.ResolveResponse {
+	return MergeMultiResolveWithPolicy(allResp, MergePolicyUnion)
+}
+
+// Merges multiple V2 ResolveResponses with the given policy.
+// Assumes the responses are in order of priority.
+func MergeMultiResolveWithPolicy(allResp []*pbv2.ResolveResponse, policy MergePolicy) *pbv2.ResolveResponse {
 	if len(allResp) == 0 {
 		return &pbv2.ResolveResponse{}
 	}
 	prev := allResp[0]
 	for i := 1; i < len(allResp); i++ {
-		cur := MergeResolve(prev, allResp[i])
+		cur := mergeResolve(prev, allResp[i], policy)
 		prev = cur
 	}
 	return prev
@@ -88,6 +104,7 @@ func MergeMultiResolve(allResp []*pbv2.ResolveResponse) *pbv2.ResolveResponse {
 
 func mergeLinkedGraph(
 	mainData, auxData map[string]*pbv2.LinkedGraph,
+	policy MergePolicy,
 ) map[string]*pbv2.LinkedGraph {
 	if mainData == nil {
 		mainData = map[string]*pbv2.LinkedGraph{}
@@ -101,6 +118,9 @@ func mergeLinkedGraph(
 			mainData[dcid] = linkedGraph
 			continue
 		}
+		if policy == MergePolicyFirstNonEmpty {
+			continue
+		}
 		mainArcs := mainData[dcid].GetArcs()
 
 		for prop, nodes := range linkedGraph.GetArcs() {
@@ -146,6 +166,10 @@ func isEmptyLinkedGraph(linkedGraph *pbv2.LinkedGraph) bool {
 
 // TODO: Add more unit tests with real data.
 func MergeNode(main, aux *pbv2.NodeResponse) (*pbv2.NodeResponse, error) {
+	return mergeNode(main, aux, MergePolicyUnion)
+}
+
+func mergeNode(main, aux *pbv2.NodeResponse, policy MergePolicy) (*pbv2.NodeResponse, error) {
 	if aux == nil {
 		return main, nil
 	}
@@ -166,7 +190,7 @@ func MergeNode(main, aux *pbv2.NodeResponse) (*pbv2.NodeResponse, error) {
 		}
 		return aux, nil
 	}
-	main.Data = mergeLinkedGraph(main.GetData(), aux.GetData())
+	main.Data = mergeLinkedGraph(main.GetData(), aux.GetData(), policy)
 	// Merge |next_token|.
 	resPaginationInfo := &pbv1.PaginationInfo{}
 	if main.GetNextToken() != "" {
@@ -197,12 +221,18 @@ func MergeNode(main, aux *pbv2.NodeResponse) (*pbv2.NodeResponse, error) {
 // Merges multiple V2 NodeResponses.
 // Assumes the responses are in order of priority.
 func MergeMultiNode(allResp []*pbv2.NodeResponse) (*pbv2.NodeResponse, error) {
+	return MergeMultiNodeWithPolicy(allResp, MergePolicyUnion)
+}
+
+// Merges multiple V2 NodeResponses with the given policy.
+// Assumes the responses are in order of priority.
+func MergeMultiNodeWithPolicy(allResp []*pbv2.NodeResponse, policy MergePolicy) (*pbv2.NodeResponse, error) {
 	if len(allResp) == 0 {
 		return &pbv2.NodeResponse{}, nil
 	}
 	prev := allResp[0]
 	for i := 1; i < len(allResp); i++ {
-		cur, err := MergeNode(prev, allResp[i])
+		cur, err := mergeNode(prev, allResp[i], policy)
 		if err != nil {
 			return nil, err
 		}
@@ -231,14 +261,22 @@ func MergeEvent(main, aux *pbv2.EventResponse) *pbv2.EventResponse {
 		dates[d] = struct{}{}
 	}
 	// Merge aux
-	mainCollection := main.GetEventCollection()
 	auxCollection := aux.GetEventCollection()
+	if main.EventCollection == nil && auxCollection != nil {
+		main.EventCollection = &pbv1.EventCollection{}
+	}
+	mainCollection := main.GetEventCollection()
+	if mainCollection != nil && mainCollection.ProvenanceInfo == nil {
+		mainCollection.ProvenanceInfo = map[string]*pbv1.EventCollection_ProvenanceInfo{}
+	}
 	for _, ev := range auxCollection.GetEvents() {
 		if _, ok := ids[ev.Dcid]; ok {
 			continue
 		}
 		mainCollection.Events = append(mainCollection.Events, ev)
-		mainCollection.ProvenanceInfo[ev.ProvenanceId] = auxCollection.ProvenanceInfo[ev.ProvenanceId]
+		if info, ok := auxCollection.ProvenanceInfo[ev.ProvenanceId]; ok {
+			mainCollection.ProvenanceInfo[ev.ProvenanceId] = info
+		}
 	}
 	if main.EventCollectionDate == nil {
 		main.EventCollectionDate = &pbv1.EventCollectionDate{}
@@ -255,6 +293,111 @@ func MergeEvent(main, aux *pbv2.EventResponse) *pbv2.EventResponse {
 	return main
 }
 
+// MergeMultiEvent merges multiple V2 event responses.
+// Events from earlier responses take precedence over later ones.
+func MergeMultiEvent(allResp []*pbv2.EventResponse) *pbv2.EventResponse {
+	if len(allResp) == 0 {
+		return &pbv2.EventResponse{}
+	}
+	prev := allResp[0]
+	for i := 1; i < len(allResp); i++ {
+		prev = MergeEvent(prev, allResp[i])
+	}
+	return prev
+}
+
+// MergeQuery merges two query responses.
+// Rows of |main| come first, followed by rows of |aux| that are not already in |main|.
+// The two responses must have the same header unless one of them has no header.
+func MergeQuery(main, aux *pb.QueryResponse) (*pb.QueryResponse, error) {
+	if main == nil || len(main.GetHeader()) == 0 {
+		if aux == nil {
+			return main, nil
+		}
+		return aux, nil
+	}
+	if aux == nil || len(aux.GetHeader()) == 0 {
+		return main, nil
+	}
+	if strings.Join(main.GetHeader(), ",") != strings.Join(aux.GetHeader(), ",") {
+		return nil, fmt.Errorf("cannot merge query responses with different headers: %v, %v",
+			main.GetHeader(), aux.GetHeader())
+	}
+	seen := map[string]struct{}{}
+	for _, row := range main.GetRows() {
+		seen[queryRowKey(row)] = struct{}{}
+	}
+	for _, row := range aux.GetRows() {
+		key := queryRowKey(row)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		main.Rows = append(main.Rows, row)
+	}
+	return main, nil
+}
+
+// MergeMultiQuery merges multiple query responses.
+// The ORDER BY and LIMIT of |opts| are re-applied to the merged rows, since each response is only
+// ordered and limited on its own.
+func MergeMultiQuery(allResp []*pb.QueryResponse, opts *types.QueryOptions) (*pb.QueryResponse, error) {
+	if len(allResp) == 0 {
+		return &pb.QueryResponse{}, nil
+	}
+	prev := allResp[0]
+	for i := 1; i < len(allResp); i++ {
+		cur, err := MergeQuery(prev, allResp[i])
+		if err != nil {
+			return nil, err
+		}
+		prev = cur
+	}
+	if prev == nil || opts == nil || len(allResp) == 1 {
+		return prev, nil
+	}
+	if opts.Orderby != "" {
+		col := -1
+		for i, h := range prev.GetHeader() {
+			if h == opts.Orderby {
+				col = i
+				break
+			}
+		}
+		if col >= 0 {
+			sort.SliceStable(prev.Rows, func(i, j int) bool {
+				left := queryCellValue(prev.Rows[i], col)
+				right := queryCellValue(prev.Rows[j], col)
+				if opts.ASC {
+					return left < right
+				}
+				return left > right
+			})
+		}
+	}
+	if opts.Limit > 0 && len(prev.Rows) > opts.Limit {
+		prev.Rows = prev.Rows[:opts.Limit]
+	}
+	return prev, nil
+}
+
+// queryCellValue returns the value of the |col|th cell of a query response row.
+func queryCellValue(row *pb.QueryResponseRow, col int) string {
+	if col >= len(row.GetCells()) {
+		return ""
+	}
+	return row.GetCells()[col].GetValue()
+}
+
+// queryRowKey identifies a query response row by its cell values.
+func queryRowKey(row *pb.QueryResponseRow) string {
+	values := []string{}
+	for _, cell := range row.GetCells() {
+		values = append(values, cell.GetValue())
+	}
+	return strings.Join(values, "\x00")
+}
+
 // MergeObservation merges two V2 observation responses.
 func MergeObservation(main, aux *pbv2.ObservationResponse) *pbv2.ObservationResponse {
 	if main == nil {
@@ -296,6 +439,68 @@ func MergeObservation(main, aux *pbv2.ObservationResponse) *pbv2.ObservationResp
 	return main
 }
 
+// mergeObservation merges two V2 observation responses with the given policy.
+// Facets of aux with the same facet ID as a facet of main are dropped.
+func mergeObservation(main, aux *pbv2.ObservationResponse, policy MergePolicy) *pbv2.ObservationResponse {
+	if main == nil {
+		return aux
+	}
+	if aux == nil {
+		return main
+	}
+	// Facets of aux that are added to main.
+	usedFacets := map[string]struct{}{}
+	for v, vData := range aux.ByVariable {
+		if main.ByVariable == nil {
+			main.ByVariable = map[string]*pbv2.VariableObservation{}
+		}
+		if _, ok := main.ByVariable[v]; !ok {
+			main.ByVariable[v] = &pbv2.VariableObservation{
+				ByEntity: map[string]*pbv2.EntityObservation{},
+			}
+		}
+		if main.ByVariable[v].ByEntity == nil {
+			main.ByVariable[v].ByEntity = map[string]*pbv2.EntityObservation{}
+		}
+		for e, eData := range vData.ByEntity {
+			if _, ok := main.ByVariable[v].ByEntity[e]; !ok {
+				main.ByVariable[v].ByEntity[e] = &pbv2.EntityObservation{
+					OrderedFacets: []*pbv2.FacetObservation{},
+				}
+			}
+			mainEntity := main.ByVariable[v].ByEntity[e]
+			if policy == MergePolicyFirstNonEmpty && len(mainEntity.OrderedFacets) > 0 {
+				continue
+			}
+			existFacets := map[string]struct{}{}
+			for _, f := range mainEntity.OrderedFacets {
+				existFacets[f.FacetId] = struct{}{}
+			}
+			for _, f := range eData.OrderedFacets {
+				if _, ok := existFacets[f.FacetId]; ok {
+					continue
+				}
+				mainEntity.OrderedFacets = append(mainEntity.OrderedFacets, f)
+				usedFacets[f.FacetId] = struct{}{}
+			}
+		}
+	}
+	if main.Facets == nil {
+		main.Facets = map[string]*pb.Facet{}
+	}
+	for facetID, facet := range aux.Facets {
+		if _, ok := main.Facets[facetID]; ok {
+			continue
+		}
+		// Facets of dropped entity observations are dropped too.
+		if _, ok := usedFacets[facetID]; policy == MergePolicyFirstNonEmpty && !ok {
+			continue
+		}
+		main.Facets[facetID] = facet
+	}
+	return main
+}
+
 // Merges multiple V2 ObservationResponses.
 // Assumes the responses are in order of priority.
 func MergeMultiObservation(allResp []*pbv2.ObservationResponse) *pbv2.ObservationResponse {
@@ -310,6 +515,20 @@ func MergeMultiObservation(allResp []*pbv2.ObservationResponse) *pbv2.Observatio
 	return prev
 }
 
+// Merges multiple V2 ObservationResponses with the given policy.
+// Assumes the responses are in order of priority.
+func MergeMultiObservationWithPolicy(allResp []*pbv2.ObservationResponse, policy MergePolicy) *pbv2.ObservationResponse {
+	if len(allResp) == 0 {
+		return &pbv2.ObservationResponse{}
+	}
+	prev := allResp[0]
+	for i := 1; i < len(allResp); i++ {
+		cur := mergeObservation(prev, allResp[i], policy)
+		prev = cur
+	}
+	return prev
+}
+
 // MergeObservationDates merges two V1 observation-dates responses.
 func MergeObservationDates(
 	main, aux *pbv1.BulkObservationDatesLinkedResponse,
@@ -440,9 +659,24 @@ func MergeSearchStatVarResponse(primary, secondary *pb.SearchStatVarResponse) *p
 // Merges multiple V2 NodeSearchResponses.
 // Cycles through responses in order of priority and add results one by one.
 func MergeMultiNodeSearch(allResp []*pbv2.NodeSearchResponse) (*pbv2.NodeSearchResponse, error) {
+	return MergeMultiNodeSearchWithPolicy(allResp, MergePolicyUnion)
+}
+
+// Merges multiple V2 NodeSearchResponses with the given policy.
+// Assumes the responses are in order of priority.
+func MergeMultiNodeSearchWithPolicy(allResp []*pbv2.NodeSearchResponse, policy MergePolicy) (*pbv2.NodeSearchResponse, error) {
 	if len(allResp) == 0 {
 		return &pbv2.NodeSearchResponse{}, nil
 	}
+	if policy == MergePolicyFirstNonEmpty {
+		for _, resp := range allResp {
+			if len(resp.GetResults()) > 0 {
+				// Still merge a single response to dedupe and cap its results.
+				allResp = []*pbv2.NodeSearchResponse{resp}
+				break
+			}
+		}
+	}
 
 	merged := &pbv2.NodeSearchResponse{}
 	results := map[string]bool{}
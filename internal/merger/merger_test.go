@@ -21,6 +21,7 @@ import (
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	pbv1 "github.com/datacommonsorg/mixer/internal/proto/v1"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/translator/types"
 	"github.com/datacommonsorg/mixer/internal/util"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/proto"
@@ -92,6 +93,81 @@ func TestMergeResolve(t *testing.T) {
 	}
 }
 
+func TestMergeMultiResolveWithPolicy(t *testing.T) {
+	cmpOpts := cmp.Options{
+		protocmp.Transform(),
+	}
+
+	newResponses := func() []*pbv2.ResolveResponse {
+		return []*pbv2.ResolveResponse{
+			{
+				Entities: []*pbv2.ResolveResponse_Entity{
+					{
+						Node:       "node1",
+						Candidates: []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "id1.1"}},
+					},
+					{
+						Node: "node2",
+					},
+				},
+			},
+			{
+				Entities: []*pbv2.ResolveResponse_Entity{
+					{
+						Node:       "node1",
+						Candidates: []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "id1.2"}},
+					},
+					{
+						Node:       "node2",
+						Candidates: []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "id2.1"}},
+					},
+				},
+			},
+		}
+	}
+
+	for _, c := range []struct {
+		policy MergePolicy
+		want   *pbv2.ResolveResponse
+	}{
+		{
+			MergePolicyUnion,
+			&pbv2.ResolveResponse{
+				Entities: []*pbv2.ResolveResponse_Entity{
+					{
+						Node:       "node1",
+						Candidates: []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "id1.1"}, {Dcid: "id1.2"}},
+					},
+					{
+						Node:       "node2",
+						Candidates: []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "id2.1"}},
+					},
+				},
+			},
+		},
+		{
+			MergePolicyFirstNonEmpty,
+			&pbv2.ResolveResponse{
+				Entities: []*pbv2.ResolveResponse_Entity{
+					{
+						Node:       "node1",
+						Candidates: []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "id1.1"}},
+					},
+					{
+						Node:       "node2",
+						Candidates: []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "id2.1"}},
+					},
+				},
+			},
+		},
+	} {
+		got := MergeMultiResolveWithPolicy(newResponses(), c.policy)
+		if diff := cmp.Diff(got, c.want, cmpOpts); diff != "" {
+			t.Errorf("MergeMultiResolveWithPolicy(%s) got diff: %s", c.policy, diff)
+		}
+	}
+}
+
 func TestMergeNode(t *testing.T) {
 	cmpOpts := cmp.Options{
 		protocmp.Transform(),
@@ -509,6 +585,36 @@ func TestMergeEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			&pbv2.EventResponse{},
+			&pbv2.EventResponse{
+				EventCollection: &pbv1.EventCollection{
+					Events: []*pbv1.EventCollection_Event{
+						{
+							Dcid:         "event2",
+							ProvenanceId: "prov2",
+						},
+					},
+					ProvenanceInfo: map[string]*pbv1.EventCollection_ProvenanceInfo{
+						"prov2": {ImportName: "import2"},
+					},
+				},
+			},
+			&pbv2.EventResponse{
+				EventCollection: &pbv1.EventCollection{
+					Events: []*pbv1.EventCollection_Event{
+						{
+							Dcid:         "event2",
+							ProvenanceId: "prov2",
+						},
+					},
+					ProvenanceInfo: map[string]*pbv1.EventCollection_ProvenanceInfo{
+						"prov2": {ImportName: "import2"},
+					},
+				},
+				EventCollectionDate: &pbv1.EventCollectionDate{},
+			},
+		},
 	} {
 		got := MergeEvent(c.e1, c.e2)
 		if diff := cmp.Diff(got, c.want, cmpOpts); diff != "" {
@@ -793,6 +899,123 @@ func TestMergeMultiObservation(t *testing.T) {
 	}
 }
 
+func TestMergeMultiObservationWithPolicy(t *testing.T) {
+	cmpOpts := cmp.Options{
+		protocmp.Transform(),
+	}
+
+	facetObs := func(facetID, date string, value float64) *pbv2.FacetObservation {
+		return &pbv2.FacetObservation{
+			FacetId:      facetID,
+			Observations: []*pb.PointStat{{Date: date, Value: proto.Float64(value)}},
+		}
+	}
+	// Custom data for entity1 only, and base data for both entities.
+	// facet1 is returned by both sources.
+	newResponses := func() []*pbv2.ObservationResponse {
+		return []*pbv2.ObservationResponse{
+			{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"var1": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"entity1": {OrderedFacets: []*pbv2.FacetObservation{facetObs("facet1", "2021", 1)}},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{"facet1": {ImportName: "custom"}},
+			},
+			{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"var1": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"entity1": {OrderedFacets: []*pbv2.FacetObservation{
+								facetObs("facet1", "2021", 2),
+								facetObs("facet2", "2022", 3),
+							}},
+							"entity2": {OrderedFacets: []*pbv2.FacetObservation{facetObs("facet2", "2022", 4)}},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{
+					"facet1": {ImportName: "base"},
+					"facet2": {ImportName: "base2"},
+				},
+			},
+		}
+	}
+
+	for _, c := range []struct {
+		policy MergePolicy
+		want   *pbv2.ObservationResponse
+	}{
+		{
+			MergePolicyUnion,
+			&pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"var1": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"entity1": {OrderedFacets: []*pbv2.FacetObservation{
+								facetObs("facet1", "2021", 1),
+								facetObs("facet2", "2022", 3),
+							}},
+							"entity2": {OrderedFacets: []*pbv2.FacetObservation{facetObs("facet2", "2022", 4)}},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{
+					"facet1": {ImportName: "custom"},
+					"facet2": {ImportName: "base2"},
+				},
+			},
+		},
+		{
+			MergePolicyFirstNonEmpty,
+			&pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"var1": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"entity1": {OrderedFacets: []*pbv2.FacetObservation{facetObs("facet1", "2021", 1)}},
+							"entity2": {OrderedFacets: []*pbv2.FacetObservation{facetObs("facet2", "2022", 4)}},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{
+					"facet1": {ImportName: "custom"},
+					"facet2": {ImportName: "base2"},
+				},
+			},
+		},
+	} {
+		got := MergeMultiObservationWithPolicy(newResponses(), c.policy)
+		if diff := cmp.Diff(got, c.want, cmpOpts); diff != "" {
+			t.Errorf("MergeMultiObservationWithPolicy(%s) got diff: %s", c.policy, diff)
+		}
+	}
+
+	// MergeMultiObservation keeps the facets of all responses, as V2 always did.
+	want := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{
+			"var1": {
+				ByEntity: map[string]*pbv2.EntityObservation{
+					"entity1": {OrderedFacets: []*pbv2.FacetObservation{
+						facetObs("facet1", "2021", 1),
+						facetObs("facet1", "2021", 2),
+						facetObs("facet2", "2022", 3),
+					}},
+					"entity2": {OrderedFacets: []*pbv2.FacetObservation{facetObs("facet2", "2022", 4)}},
+				},
+			},
+		},
+		Facets: map[string]*pb.Facet{
+			"facet1": {ImportName: "base"},
+			"facet2": {ImportName: "base2"},
+		},
+	}
+	if diff := cmp.Diff(MergeMultiObservation(newResponses()), want, cmpOpts); diff != "" {
+		t.Errorf("MergeMultiObservation got diff: %s", diff)
+	}
+}
+
 func TestMergeBulkVariableInfoResponse(t *testing.T) {
 	cmpOpts := cmp.Options{protocmp.Transform()}
 	for _, tc := range []struct {
@@ -1202,3 +1425,143 @@ func TestMergeMultiNodeSearch(t *testing.T) {
 		}
 	}
 }
+
+func TestMergeMultiQuery(t *testing.T) {
+	cmpOpts := cmp.Options{protocmp.Transform()}
+	row := func(values ...string) *pb.QueryResponseRow {
+		r := &pb.QueryResponseRow{}
+		for _, v := range values {
+			r.Cells = append(r.Cells, &pb.QueryResponseCell{Value: v})
+		}
+		return r
+	}
+	for _, c := range []struct {
+		allResp []*pb.QueryResponse
+		opts    *types.QueryOptions
+		want    *pb.QueryResponse
+		wantErr bool
+	}{
+		{
+			[]*pb.QueryResponse{
+				{
+					Header: []string{"?dcid", "?name"},
+					Rows:   []*pb.QueryResponseRow{row("geoId/06", "California"), row("geoId/08", "Colorado")},
+				},
+				{},
+				{
+					Header: []string{"?dcid", "?name"},
+					Rows:   []*pb.QueryResponseRow{row("geoId/08", "Colorado"), row("geoId/10", "Delaware")},
+				},
+			},
+			&types.QueryOptions{},
+			&pb.QueryResponse{
+				Header: []string{"?dcid", "?name"},
+				Rows: []*pb.QueryResponseRow{
+					row("geoId/06", "California"),
+					row("geoId/08", "Colorado"),
+					row("geoId/10", "Delaware"),
+				},
+			},
+			false,
+		},
+		{
+			[]*pb.QueryResponse{
+				{},
+				{
+					Header: []string{"?name"},
+					Rows:   []*pb.QueryResponseRow{row("California")},
+				},
+			},
+			&types.QueryOptions{},
+			&pb.QueryResponse{
+				Header: []string{"?name"},
+				Rows:   []*pb.QueryResponseRow{row("California")},
+			},
+			false,
+		},
+		{
+			// Each source is ordered and limited on its own, so the merged rows are re-ordered and
+			// re-limited.
+			[]*pb.QueryResponse{
+				{
+					Header: []string{"?dcid", "?name"},
+					Rows: []*pb.QueryResponseRow{
+						row("geoId/10", "Delaware"),
+						row("geoId/08", "Colorado"),
+						row("geoId/04", "Arizona"),
+					},
+				},
+				{
+					Header: []string{"?dcid", "?name"},
+					Rows: []*pb.QueryResponseRow{
+						row("geoId/12", "Florida"),
+						row("geoId/08", "Colorado"),
+						row("geoId/06", "California"),
+					},
+				},
+			},
+			&types.QueryOptions{Orderby: "?dcid", ASC: false, Limit: 3},
+			&pb.QueryResponse{
+				Header: []string{"?dcid", "?name"},
+				Rows: []*pb.QueryResponseRow{
+					row("geoId/12", "Florida"),
+					row("geoId/10", "Delaware"),
+					row("geoId/08", "Colorado"),
+				},
+			},
+			false,
+		},
+		{
+			[]*pb.QueryResponse{
+				{
+					Header: []string{"?dcid", "?name"},
+					Rows:   []*pb.QueryResponseRow{row("geoId/08", "Colorado"), row("geoId/06", "California")},
+				},
+				{
+					Header: []string{"?dcid", "?name"},
+					Rows:   []*pb.QueryResponseRow{row("geoId/04", "Arizona"), row("geoId/10", "Delaware")},
+				},
+			},
+			&types.QueryOptions{Orderby: "?name", ASC: true},
+			&pb.QueryResponse{
+				Header: []string{"?dcid", "?name"},
+				Rows: []*pb.QueryResponseRow{
+					row("geoId/04", "Arizona"),
+					row("geoId/06", "California"),
+					row("geoId/08", "Colorado"),
+					row("geoId/10", "Delaware"),
+				},
+			},
+			false,
+		},
+		{
+			[]*pb.QueryResponse{
+				{
+					Header: []string{"?dcid"},
+					Rows:   []*pb.QueryResponseRow{row("geoId/06")},
+				},
+				{
+					Header: []string{"?name"},
+					Rows:   []*pb.QueryResponseRow{row("California")},
+				},
+			},
+			&types.QueryOptions{},
+			nil,
+			true,
+		},
+	} {
+		got, err := MergeMultiQuery(c.allResp, c.opts)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("MergeMultiQuery(%v) = nil, want error", c.allResp)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("MergeMultiQuery(%v) got err: %s", c.allResp, err)
+		}
+		if diff := cmp.Diff(got, c.want, cmpOpts); diff != "" {
+			t.Errorf("MergeMultiQuery(%v) got diff: %s", c.allResp, diff)
+		}
+	}
+}
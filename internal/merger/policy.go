@@ -0,0 +1,42 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merger
+
+import "fmt"
+
+// MergePolicy determines how the data of responses from different sources is combined.
+// Responses are always merged in order of priority.
+type MergePolicy string
+
+const (
+	// MergePolicyUnion keeps the data of all responses.
+	// Duplicates (facets, candidates, arc nodes and search results) are taken from the higher priority response.
+	MergePolicyUnion MergePolicy = "union"
+	// MergePolicyFirstNonEmpty only keeps the data of the highest priority response that has any,
+	// per variable and entity for observations and per node for node and resolve responses.
+	// Search results are taken from the highest priority response with results.
+	MergePolicyFirstNonEmpty MergePolicy = "first_non_empty"
+)
+
+// ParseMergePolicy parses a merge policy name. The empty name is the union policy.
+func ParseMergePolicy(name string) (MergePolicy, error) {
+	switch policy := MergePolicy(name); policy {
+	case "":
+		return MergePolicyUnion, nil
+	case MergePolicyUnion, MergePolicyFirstNonEmpty:
+		return policy, nil
+	}
+	return "", fmt.Errorf("invalid merge policy: %s", name)
+}
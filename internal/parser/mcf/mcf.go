@@ -15,6 +15,7 @@
 package mcf
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/datacommonsorg/mixer/internal/translator/types"
@@ -56,3 +57,128 @@ func ParseMapping(mcf, database string) ([]*types.Mapping, error) {
 	}
 	return mappings, nil
 }
+
+// Triple is a (subject, predicate, object) triple of an instance mcf node.
+// The object is either a reference to another node (ObjectID) or a literal value (ObjectValue).
+type Triple struct {
+	SubjectID   string
+	Predicate   string
+	ObjectID    string
+	ObjectValue string
+}
+
+// Namespace prefixes of node references.
+var referencePrefixes = []string{"dcid:", "dcs:", "schema:"}
+
+// ParseTriples parses instance mcf into a list of triples.
+//
+// The subject of a node is its dcid property if set, or the Node identifier otherwise.
+// Quoted and numeric values are literal values, other values are node references.
+// Property values are comma separated.
+func ParseTriples(mcf string) ([]*Triple, error) {
+	triples := []*Triple{}
+	var sub string
+	var nodeTriples []*Triple
+	flush := func() {
+		for _, t := range nodeTriples {
+			t.SubjectID = sub
+		}
+		triples = append(triples, nodeTriples...)
+		nodeTriples = nil
+	}
+	for _, line := range strings.Split(mcf, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "//") || strings.HasPrefix(line, "#") || line == "" {
+			continue
+		}
+		head, body, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, status.Errorf(
+				codes.InvalidArgument, "invalid instance mcf line: %s", line)
+		}
+		head = strings.TrimSpace(head)
+		values, err := splitValues(body)
+		if err != nil {
+			return nil, err
+		}
+
+		switch head {
+		case "Node":
+			flush()
+			if len(values) != 1 {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid Node identifier: %s", line)
+			}
+			sub = stripReferencePrefix(values[0])
+		case "dcid":
+			if sub == "" || len(values) != 1 {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid dcid: %s", line)
+			}
+			sub = unquote(values[0])
+		default:
+			if sub == "" {
+				return nil, status.Error(codes.InvalidArgument, "Missing Node identifier")
+			}
+			for _, value := range values {
+				t := &Triple{Predicate: head}
+				if isLiteral(value) {
+					t.ObjectValue = unquote(value)
+				} else {
+					t.ObjectID = stripReferencePrefix(value)
+				}
+				nodeTriples = append(nodeTriples, t)
+			}
+		}
+	}
+	flush()
+	return triples, nil
+}
+
+// splitValues splits comma separated property values, keeping the quotes of quoted values.
+func splitValues(body string) ([]string, error) {
+	values := []string{}
+	var current strings.Builder
+	quoted := false
+	add := func() {
+		if value := strings.TrimSpace(current.String()); value != "" {
+			values = append(values, value)
+		}
+		current.Reset()
+	}
+	for _, r := range body {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			current.WriteRune(r)
+		case r == ',' && !quoted:
+			add()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if quoted {
+		return nil, status.Errorf(codes.InvalidArgument, "unterminated quote in mcf values: %s", body)
+	}
+	add()
+	return values, nil
+}
+
+func isLiteral(value string) bool {
+	if strings.HasPrefix(value, `"`) {
+		return true
+	}
+	_, err := strconv.ParseFloat(value, 64)
+	return err == nil
+}
+
+func unquote(value string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(value, `"`), `"`)
+}
+
+func stripReferencePrefix(value string) string {
+	for _, prefix := range referencePrefixes {
+		if strings.HasPrefix(value, prefix) {
+			return strings.TrimSpace(strings.TrimPrefix(value, prefix))
+		}
+	}
+	return value
+}
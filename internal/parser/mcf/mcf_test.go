@@ -136,3 +136,63 @@ func TestParseMapping(t *testing.T) {
 		}
 	}
 }
+
+func TestParseTriples(t *testing.T) {
+	for _, c := range []struct {
+		mcf         string
+		wantTriples []*Triple
+		wantErr     bool
+	}{
+		{
+			`Node: dcid:geoId/06
+			 typeOf: dcs:State
+			 name: "California"
+			 containedInPlace: dcid:country/USA, dcid:northamerica
+
+			 # Local node id with a dcid.
+			 Node: CA_Count_Person
+			 dcid: "Count_Person_CA"
+			 typeOf: StatisticalVariable
+			 populationType: schema:Person
+			 description: "People, in California"
+			 latitude: 37.5`,
+			[]*Triple{
+				{SubjectID: "geoId/06", Predicate: "typeOf", ObjectID: "State"},
+				{SubjectID: "geoId/06", Predicate: "name", ObjectValue: "California"},
+				{SubjectID: "geoId/06", Predicate: "containedInPlace", ObjectID: "country/USA"},
+				{SubjectID: "geoId/06", Predicate: "containedInPlace", ObjectID: "northamerica"},
+				{SubjectID: "Count_Person_CA", Predicate: "typeOf", ObjectID: "StatisticalVariable"},
+				{SubjectID: "Count_Person_CA", Predicate: "populationType", ObjectID: "Person"},
+				{SubjectID: "Count_Person_CA", Predicate: "description", ObjectValue: "People, in California"},
+				{SubjectID: "Count_Person_CA", Predicate: "latitude", ObjectValue: "37.5"},
+			},
+			false,
+		},
+		{
+			`typeOf: State`,
+			nil,
+			true,
+		},
+		{
+			`Node: geoId/06
+			 name: "California`,
+			nil,
+			true,
+		},
+	} {
+		gotTriples, err := ParseTriples(c.mcf)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("ParseTriples(%s) = nil, want error", c.mcf)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseTriples(%s) = %s", c.mcf, err)
+			continue
+		}
+		if diff := deep.Equal(c.wantTriples, gotTriples); diff != nil {
+			t.Errorf("MCF: %s; unexpected parse diff %+v", c.mcf, diff)
+		}
+	}
+}
@@ -47,6 +47,8 @@ const (
 	Mixer_V3Observation_FullMethodName                = "/datacommons.Mixer/V3Observation"
 	Mixer_V3NodeSearch_FullMethodName                 = "/datacommons.Mixer/V3NodeSearch"
 	Mixer_V3Resolve_FullMethodName                    = "/datacommons.Mixer/V3Resolve"
+	Mixer_V3Event_FullMethodName                      = "/datacommons.Mixer/V3Event"
+	Mixer_V3Sparql_FullMethodName                     = "/datacommons.Mixer/V3Sparql"
 	Mixer_V2Sparql_FullMethodName                     = "/datacommons.Mixer/V2Sparql"
 	Mixer_V2Resolve_FullMethodName                    = "/datacommons.Mixer/V2Resolve"
 	Mixer_V2Node_FullMethodName                       = "/datacommons.Mixer/V2Node"
@@ -122,6 +124,8 @@ type MixerClient interface {
 	V3Observation(ctx context.Context, in *v2.ObservationRequest, opts ...grpc.CallOption) (*v2.ObservationResponse, error)
 	V3NodeSearch(ctx context.Context, in *v2.NodeSearchRequest, opts ...grpc.CallOption) (*v2.NodeSearchResponse, error)
 	V3Resolve(ctx context.Context, in *v2.ResolveRequest, opts ...grpc.CallOption) (*v2.ResolveResponse, error)
+	V3Event(ctx context.Context, in *v2.EventRequest, opts ...grpc.CallOption) (*v2.EventResponse, error)
+	V3Sparql(ctx context.Context, in *proto.SparqlRequest, opts ...grpc.CallOption) (*proto.QueryResponse, error)
 	V2Sparql(ctx context.Context, in *proto.SparqlRequest, opts ...grpc.CallOption) (*proto.QueryResponse, error)
 	V2Resolve(ctx context.Context, in *v2.ResolveRequest, opts ...grpc.CallOption) (*v2.ResolveResponse, error)
 	V2Node(ctx context.Context, in *v2.NodeRequest, opts ...grpc.CallOption) (*v2.NodeResponse, error)
@@ -286,6 +290,24 @@ func (c *mixerClient) V3Resolve(ctx context.Context, in *v2.ResolveRequest, opts
 	return out, nil
 }
 
+func (c *mixerClient) V3Event(ctx context.Context, in *v2.EventRequest, opts ...grpc.CallOption) (*v2.EventResponse, error) {
+	out := new(v2.EventResponse)
+	err := c.cc.Invoke(ctx, Mixer_V3Event_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mixerClient) V3Sparql(ctx context.Context, in *proto.SparqlRequest, opts ...grpc.CallOption) (*proto.QueryResponse, error) {
+	out := new(proto.QueryResponse)
+	err := c.cc.Invoke(ctx, Mixer_V3Sparql_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mixerClient) V2Sparql(ctx context.Context, in *proto.SparqlRequest, opts ...grpc.CallOption) (*proto.QueryResponse, error) {
 	out := new(proto.QueryResponse)
 	err := c.cc.Invoke(ctx, Mixer_V2Sparql_FullMethodName, in, out, opts...)
@@ -879,6 +901,8 @@ type MixerServer interface {
 	V3Observation(context.Context, *v2.ObservationRequest) (*v2.ObservationResponse, error)
 	V3NodeSearch(context.Context, *v2.NodeSearchRequest) (*v2.NodeSearchResponse, error)
 	V3Resolve(context.Context, *v2.ResolveRequest) (*v2.ResolveResponse, error)
+	V3Event(context.Context, *v2.EventRequest) (*v2.EventResponse, error)
+	V3Sparql(context.Context, *proto.SparqlRequest) (*proto.QueryResponse, error)
 	V2Sparql(context.Context, *proto.SparqlRequest) (*proto.QueryResponse, error)
 	V2Resolve(context.Context, *v2.ResolveRequest) (*v2.ResolveResponse, error)
 	V2Node(context.Context, *v2.NodeRequest) (*v2.NodeResponse, error)
@@ -1015,6 +1039,12 @@ func (UnimplementedMixerServer) V3NodeSearch(context.Context, *v2.NodeSearchRequ
 func (UnimplementedMixerServer) V3Resolve(context.Context, *v2.ResolveRequest) (*v2.ResolveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method V3Resolve not implemented")
 }
+func (UnimplementedMixerServer) V3Event(context.Context, *v2.EventRequest) (*v2.EventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method V3Event not implemented")
+}
+func (UnimplementedMixerServer) V3Sparql(context.Context, *proto.SparqlRequest) (*proto.QueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method V3Sparql not implemented")
+}
 func (UnimplementedMixerServer) V2Sparql(context.Context, *proto.SparqlRequest) (*proto.QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method V2Sparql not implemented")
 }
@@ -1294,6 +1324,42 @@ func _Mixer_V3Resolve_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mixer_V3Event_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v2.EventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerServer).V3Event(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mixer_V3Event_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerServer).V3Event(ctx, req.(*v2.EventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mixer_V3Sparql_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proto.SparqlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerServer).V3Sparql(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mixer_V3Sparql_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerServer).V3Sparql(ctx, req.(*proto.SparqlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mixer_V2Sparql_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(proto.SparqlRequest)
 	if err := dec(in); err != nil {
@@ -2487,6 +2553,14 @@ var Mixer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "V3Resolve",
 			Handler:    _Mixer_V3Resolve_Handler,
 		},
+		{
+			MethodName: "V3Event",
+			Handler:    _Mixer_V3Event_Handler,
+		},
+		{
+			MethodName: "V3Sparql",
+			Handler:    _Mixer_V3Sparql_Handler,
+		},
 		{
 			MethodName: "V2Sparql",
 			Handler:    _Mixer_V2Sparql_Handler,
@@ -369,13 +369,31 @@ type ObservationRequest struct {
 	//   - "LATEST": latest obseration of each facet is returned
 	//   - "<DATE>": a speficied valid ISO 8601 date. Observation corresponding to
 	//     this date is returned.
+	//   - "<START>..<END>": an inclusive range of ISO 8601 dates, e.g.
+	//     "2010..2020". Either bound can be omitted for an open-ended range, e.g.
+	//     "2015.." or "..2020-06". Dates within the end date, e.g. "2020-05" for
+	//     "2020", are in the range.
+	//   - "COMMON_LATEST": observations of the most recent date that most entities
+	//     have data for, so that the values of all entities are for the same date.
 	Date string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	// Value of the observation
+	// Filter of the observation values, one of:
+	//   - A comparison with a number: ">1000", ">=1000", "<0.5", "<=0.5" or "=3"
+	//   - An inclusive range of numbers: "1000..5000"
+	// Facets left without observations are removed. The filter only applies to
+	// requests that select "date" and "value"; facet requests are not filtered.
 	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
 	// [Optional] filter returned observations by facet
 	Filter *FacetFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 	// Fields to return, valid values are: "variable", "entity", "date", "value", "facet"
 	Select []string `protobuf:"bytes,6,rep,name=select,proto3" json:"select,omitempty"`
+	// [Optional] A statistical variable DCID to divide the observations by, for
+	// the same entities, e.g. "Count_Person" for per capita values. Requires
+	// selecting "date" and "value". The value filter applies to the ratios.
+	Denominator string `protobuf:"bytes,7,opt,name=denominator,proto3" json:"denominator,omitempty"`
+	// [Optional] Aggregation of the observations of child places into the
+	// requested places without observations. Requires selecting "date" and
+	// "value".
+	Aggregation *Aggregation `protobuf:"bytes,8,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
 }
 
 func (x *ObservationRequest) Reset() {
@@ -452,6 +470,81 @@ func (x *ObservationRequest) GetSelect() []string {
 	return nil
 }
 
+func (x *ObservationRequest) GetDenominator() string {
+	if x != nil {
+		return x.Denominator
+	}
+	return ""
+}
+
+func (x *ObservationRequest) GetAggregation() *Aggregation {
+	if x != nil {
+		return x.Aggregation
+	}
+	return nil
+}
+
+// Aggregation of the observations of child places into their parent place.
+type Aggregation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Method of the aggregation, one of "sum", "mean",
+	// "population_weighted_mean", "min" or "max".
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// [Optional] Type of the child places, e.g. "County". Required for entity
+	// dcids, otherwise the default child place types of the requested place type
+	// are used.
+	ChildType string `protobuf:"bytes,2,opt,name=child_type,json=childType,proto3" json:"child_type,omitempty"`
+}
+
+func (x *Aggregation) Reset() {
+	*x = Aggregation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_observation_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Aggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregation) ProtoMessage() {}
+
+func (x *Aggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_observation_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregation.ProtoReflect.Descriptor instead.
+func (*Aggregation) Descriptor() ([]byte, []int) {
+	return file_v2_observation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Aggregation) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Aggregation) GetChildType() string {
+	if x != nil {
+		return x.ChildType
+	}
+	return ""
+}
+
 type ObservationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -466,7 +559,7 @@ type ObservationResponse struct {
 func (x *ObservationResponse) Reset() {
 	*x = ObservationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_observation_proto_msgTypes[6]
+		mi := &file_v2_observation_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -479,7 +572,7 @@ func (x *ObservationResponse) String() string {
 func (*ObservationResponse) ProtoMessage() {}
 
 func (x *ObservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_observation_proto_msgTypes[6]
+	mi := &file_v2_observation_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,7 +585,7 @@ func (x *ObservationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObservationResponse.ProtoReflect.Descriptor instead.
 func (*ObservationResponse) Descriptor() ([]byte, []int) {
-	return file_v2_observation_proto_rawDescGZIP(), []int{6}
+	return file_v2_observation_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ObservationResponse) GetByVariable() map[string]*VariableObservation {
@@ -557,7 +650,7 @@ var file_v2_observation_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6f, 0x6d,
 	0x61, 0x69, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x63, 0x65, 0x74, 0x5f, 0x69, 0x64,
 	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x63, 0x65, 0x74, 0x49, 0x64,
-	0x73, 0x22, 0x83, 0x02, 0x0a, 0x12, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x73, 0x22, 0xe4, 0x02, 0x0a, 0x12, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69,
 	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x61, 0x74,
 	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x63, 0x69, 0x64,
@@ -573,33 +666,44 @@ var file_v2_observation_proto_rawDesc = []byte{
 	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x61, 0x63, 0x65,
 	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
 	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x22, 0xe7, 0x02, 0x0a, 0x13, 0x4f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x54, 0x0a, 0x0b, 0x62, 0x79, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x79, 0x56, 0x61, 0x72, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x62, 0x79, 0x56, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x1a, 0x62,
-	0x0a, 0x0f, 0x42, 0x79, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x76, 0x32, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x4d, 0x0a, 0x0b, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x67, 0x2f, 0x6d,
-	0x69, 0x78, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x0b, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x0b, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x22, 0xe7,
+	0x02, 0x0a, 0x13, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x62, 0x79, 0x5f, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x42, 0x79, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0a, 0x62, 0x79, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x06,
+	0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66,
+	0x61, 0x63, 0x65, 0x74, 0x73, 0x1a, 0x62, 0x0a, 0x0f, 0x42, 0x79, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4d, 0x0a, 0x0b, 0x46, 0x61, 0x63,
+	0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x6f, 0x72, 0x67, 0x2f, 0x6d, 0x69, 0x78, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x32, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -614,7 +718,7 @@ func file_v2_observation_proto_rawDescGZIP() []byte {
 	return file_v2_observation_proto_rawDescData
 }
 
-var file_v2_observation_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_v2_observation_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_v2_observation_proto_goTypes = []interface{}{
 	(*DcidOrExpression)(nil),    // 0: datacommons.v2.DcidOrExpression
 	(*VariableObservation)(nil), // 1: datacommons.v2.VariableObservation
@@ -622,30 +726,32 @@ var file_v2_observation_proto_goTypes = []interface{}{
 	(*FacetObservation)(nil),    // 3: datacommons.v2.FacetObservation
 	(*FacetFilter)(nil),         // 4: datacommons.v2.FacetFilter
 	(*ObservationRequest)(nil),  // 5: datacommons.v2.ObservationRequest
-	(*ObservationResponse)(nil), // 6: datacommons.v2.ObservationResponse
-	nil,                         // 7: datacommons.v2.VariableObservation.ByEntityEntry
-	nil,                         // 8: datacommons.v2.ObservationResponse.ByVariableEntry
-	nil,                         // 9: datacommons.v2.ObservationResponse.FacetsEntry
-	(*proto.PointStat)(nil),     // 10: datacommons.PointStat
-	(*proto.Facet)(nil),         // 11: datacommons.Facet
+	(*Aggregation)(nil),         // 6: datacommons.v2.Aggregation
+	(*ObservationResponse)(nil), // 7: datacommons.v2.ObservationResponse
+	nil,                         // 8: datacommons.v2.VariableObservation.ByEntityEntry
+	nil,                         // 9: datacommons.v2.ObservationResponse.ByVariableEntry
+	nil,                         // 10: datacommons.v2.ObservationResponse.FacetsEntry
+	(*proto.PointStat)(nil),     // 11: datacommons.PointStat
+	(*proto.Facet)(nil),         // 12: datacommons.Facet
 }
 var file_v2_observation_proto_depIdxs = []int32{
-	7,  // 0: datacommons.v2.VariableObservation.by_entity:type_name -> datacommons.v2.VariableObservation.ByEntityEntry
+	8,  // 0: datacommons.v2.VariableObservation.by_entity:type_name -> datacommons.v2.VariableObservation.ByEntityEntry
 	3,  // 1: datacommons.v2.EntityObservation.ordered_facets:type_name -> datacommons.v2.FacetObservation
-	10, // 2: datacommons.v2.FacetObservation.observations:type_name -> datacommons.PointStat
+	11, // 2: datacommons.v2.FacetObservation.observations:type_name -> datacommons.PointStat
 	0,  // 3: datacommons.v2.ObservationRequest.variable:type_name -> datacommons.v2.DcidOrExpression
 	0,  // 4: datacommons.v2.ObservationRequest.entity:type_name -> datacommons.v2.DcidOrExpression
 	4,  // 5: datacommons.v2.ObservationRequest.filter:type_name -> datacommons.v2.FacetFilter
-	8,  // 6: datacommons.v2.ObservationResponse.by_variable:type_name -> datacommons.v2.ObservationResponse.ByVariableEntry
-	9,  // 7: datacommons.v2.ObservationResponse.facets:type_name -> datacommons.v2.ObservationResponse.FacetsEntry
-	2,  // 8: datacommons.v2.VariableObservation.ByEntityEntry.value:type_name -> datacommons.v2.EntityObservation
-	1,  // 9: datacommons.v2.ObservationResponse.ByVariableEntry.value:type_name -> datacommons.v2.VariableObservation
-	11, // 10: datacommons.v2.ObservationResponse.FacetsEntry.value:type_name -> datacommons.Facet
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	6,  // 6: datacommons.v2.ObservationRequest.aggregation:type_name -> datacommons.v2.Aggregation
+	9,  // 7: datacommons.v2.ObservationResponse.by_variable:type_name -> datacommons.v2.ObservationResponse.ByVariableEntry
+	10, // 8: datacommons.v2.ObservationResponse.facets:type_name -> datacommons.v2.ObservationResponse.FacetsEntry
+	2,  // 9: datacommons.v2.VariableObservation.ByEntityEntry.value:type_name -> datacommons.v2.EntityObservation
+	1,  // 10: datacommons.v2.ObservationResponse.ByVariableEntry.value:type_name -> datacommons.v2.VariableObservation
+	12, // 11: datacommons.v2.ObservationResponse.FacetsEntry.value:type_name -> datacommons.Facet
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_v2_observation_proto_init() }
@@ -727,6 +833,18 @@ func file_v2_observation_proto_init() {
 			}
 		}
 		file_v2_observation_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Aggregation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v2_observation_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ObservationResponse); i {
 			case 0:
 				return &v.state
@@ -745,7 +863,7 @@ func file_v2_observation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v2_observation_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,118 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cacheutil holds the request handling shared by the response cache processors.
+package cacheutil
+
+import (
+	"sort"
+	"strings"
+
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	v2 "github.com/datacommonsorg/mixer/internal/server/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// Canonicalize returns a copy of a request in a canonical form, so that requests with the same response
+// have the same cache key. DCID lists that are served as maps are sorted and deduped, and arrow expressions
+// are normalized. Lists whose order is reflected in the response, e.g. the nodes of a resolve request, are kept.
+func Canonicalize(request proto.Message) proto.Message {
+	request = proto.Clone(request)
+	switch req := request.(type) {
+	case *pbv2.NodeRequest:
+		req.Nodes = sortedSet(req.Nodes)
+		req.Property = canonicalProperty(req.Property)
+	case *pbv2.ObservationRequest:
+		canonicalizeDcidOrExpression(req.Variable)
+		canonicalizeDcidOrExpression(req.Entity)
+		req.Select = sortedSet(req.Select)
+		if req.Filter != nil {
+			req.Filter.Domains = sortedSet(req.Filter.Domains)
+			req.Filter.FacetIds = sortedSet(req.Filter.FacetIds)
+		}
+	case *pbv2.NodeSearchRequest:
+		req.Types = sortedSet(req.Types)
+		req.Predicates = sortedSet(req.Predicates)
+	case *pbv2.ResolveRequest:
+		req.Property = canonicalProperty(req.Property)
+	}
+	return request
+}
+
+func canonicalizeDcidOrExpression(in *pbv2.DcidOrExpression) {
+	if in == nil {
+		return
+	}
+	in.Dcids = sortedSet(in.Dcids)
+	in.Expression = canonicalExpression(in.Expression)
+}
+
+// canonicalProperty normalizes a property expression, e.g. "<-containedInPlace+{typeOf:City}->name".
+// Invalid expressions are kept as is.
+func canonicalProperty(expr string) string {
+	if expr == "" {
+		return expr
+	}
+	arcs, err := v2.ParseProperty(expr)
+	if err != nil {
+		return expr
+	}
+	return arcsString(arcs)
+}
+
+// canonicalExpression normalizes a linked nodes expression, e.g. "geoId/06<-containedInPlace+{typeOf:County}".
+// Expressions that aren't linked nodes, e.g. formulas, are kept as is.
+func canonicalExpression(expr string) string {
+	if expr == "" {
+		return expr
+	}
+	linkedNodes, err := v2.ParseLinkedNodes(expr)
+	if err != nil {
+		return expr
+	}
+	return linkedNodes.Subject + arcsString(linkedNodes.Arcs)
+}
+
+func arcsString(arcs []*v2.Arc) string {
+	var sb strings.Builder
+	for _, arc := range arcs {
+		canonical := *arc
+		canonical.BracketProps = sortedSet(arc.BracketProps)
+		if len(arc.Filter) > 0 {
+			canonical.Filter = map[string][]string{}
+			for prop, values := range arc.Filter {
+				canonical.Filter[prop] = sortedSet(values)
+			}
+		}
+		sb.WriteString(canonical.String())
+	}
+	return sb.String()
+}
+
+// sortedSet returns the sorted distinct values of a list.
+func sortedSet(values []string) []string {
+	if len(values) == 0 {
+		return values
+	}
+	seen := map[string]struct{}{}
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			result = append(result, value)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
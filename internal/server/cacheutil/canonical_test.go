@@ -0,0 +1,106 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cacheutil
+
+import (
+	"testing"
+
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestCanonicalize(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		request proto.Message
+		want    proto.Message
+	}{
+		{
+			name: "node",
+			request: &pbv2.NodeRequest{
+				Nodes:    []string{"geoId/06", "country/USA", "geoId/06"},
+				Property: "<- containedInPlace+{typeOf:[State, County]} -> [name, typeOf]",
+			},
+			want: &pbv2.NodeRequest{
+				Nodes:    []string{"country/USA", "geoId/06"},
+				Property: "<-containedInPlace+{typeOf:[County,State]}->[name,typeOf]",
+			},
+		},
+		{
+			name: "node properties",
+			request: &pbv2.NodeRequest{
+				Nodes:    []string{"geoId/06"},
+				Property: "->",
+			},
+			want: &pbv2.NodeRequest{
+				Nodes:    []string{"geoId/06"},
+				Property: "->",
+			},
+		},
+		{
+			name: "invalid property",
+			request: &pbv2.NodeRequest{
+				Nodes:    []string{"geoId/06"},
+				Property: "->{typeOf",
+			},
+			want: &pbv2.NodeRequest{
+				Nodes:    []string{"geoId/06"},
+				Property: "->{typeOf",
+			},
+		},
+		{
+			name: "observation",
+			request: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"Count_Person", "Age", "Count_Person"}},
+				Entity:   &pbv2.DcidOrExpression{Expression: "country/USA <-containedInPlace+{typeOf: State}"},
+				Select:   []string{"variable", "entity", "value", "date"},
+				Filter:   &pbv2.FacetFilter{FacetIds: []string{"2", "1"}},
+			},
+			want: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"Age", "Count_Person"}},
+				Entity:   &pbv2.DcidOrExpression{Expression: "country/USA<-containedInPlace+{typeOf:State}"},
+				Select:   []string{"date", "entity", "value", "variable"},
+				Filter:   &pbv2.FacetFilter{FacetIds: []string{"1", "2"}},
+			},
+		},
+		{
+			name: "formula is kept",
+			request: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Formula: "Count_Person / Area"},
+			},
+			want: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Formula: "Count_Person / Area"},
+			},
+		},
+		{
+			name: "resolve nodes keep their order",
+			request: &pbv2.ResolveRequest{
+				Nodes:    []string{"Q30", "Q1612"},
+				Property: "<-wikidataId ->dcid",
+			},
+			want: &pbv2.ResolveRequest{
+				Nodes:    []string{"Q30", "Q1612"},
+				Property: "<-wikidataId->dcid",
+			},
+		},
+	} {
+		got := Canonicalize(tc.request)
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cacheutil
+
+import (
+	"context"
+
+	"github.com/datacommonsorg/mixer/internal/merger"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxSplitEntities is the max number of entities of an observation request that are cached separately.
+// It bounds the number of cache reads and writes per request.
+const maxSplitEntities = 100
+
+// partialRequest is an observation request that is partly served from cached entities.
+type partialRequest struct {
+	// Request before it was narrowed to the entities that aren't cached.
+	request *pbv2.ObservationRequest
+	// Cached responses of the other entities.
+	cached []*pbv2.ObservationResponse
+}
+
+// partialKey is the context key of the partial request of a processor.
+type partialKey struct {
+	processor any
+}
+
+// SplitObservationRequest returns the single-entity requests of an observation request, keyed by entity.
+// It returns nil for requests that aren't split, i.e. with expressions or too many entities.
+func SplitObservationRequest(req *pbv2.ObservationRequest) map[string]*pbv2.ObservationRequest {
+	entities := sortedSet(req.GetEntity().GetDcids())
+	if req.GetEntity().GetExpression() != "" || len(req.GetVariable().GetDcids()) == 0 ||
+		len(entities) == 0 || len(entities) > maxSplitEntities {
+		return nil
+	}
+	result := map[string]*pbv2.ObservationRequest{}
+	for _, entity := range entities {
+		entityReq := proto.Clone(req).(*pbv2.ObservationRequest)
+		entityReq.Entity = &pbv2.DcidOrExpression{Dcids: []string{entity}}
+		result[entity] = entityReq
+	}
+	return result
+}
+
+// SplitObservationResponse splits the response of an observation request by entity.
+// It returns nil if the request isn't split or if the response can't be split, e.g. if it has facets
+// that no entity refers to.
+func SplitObservationResponse(req *pbv2.ObservationRequest, resp *pbv2.ObservationResponse) map[string]*pbv2.ObservationResponse {
+	entityReqs := SplitObservationRequest(req)
+	if entityReqs == nil {
+		return nil
+	}
+	result := map[string]*pbv2.ObservationResponse{}
+	for entity := range entityReqs {
+		result[entity] = &pbv2.ObservationResponse{ByVariable: map[string]*pbv2.VariableObservation{}}
+	}
+	usedFacets := map[string]struct{}{}
+	for variable, variableObs := range resp.GetByVariable() {
+		for _, entityResp := range result {
+			entityResp.ByVariable[variable] = &pbv2.VariableObservation{ByEntity: map[string]*pbv2.EntityObservation{}}
+		}
+		for entity, entityObs := range variableObs.GetByEntity() {
+			entityResp, ok := result[entity]
+			if !ok {
+				return nil
+			}
+			entityResp.ByVariable[variable].ByEntity[entity] = entityObs
+			for _, facetObs := range entityObs.GetOrderedFacets() {
+				facet, ok := resp.GetFacets()[facetObs.GetFacetId()]
+				if !ok {
+					continue
+				}
+				if entityResp.Facets == nil {
+					entityResp.Facets = map[string]*pb.Facet{}
+				}
+				entityResp.Facets[facetObs.GetFacetId()] = facet
+				usedFacets[facetObs.GetFacetId()] = struct{}{}
+			}
+		}
+	}
+	if len(usedFacets) != len(resp.GetFacets()) {
+		return nil
+	}
+	return result
+}
+
+// ServeCachedEntities serves a multi-entity observation request from the cached responses of its entities.
+// get returns the cached response of a request, or nil if there is none.
+//
+// It returns Done if all entities are cached. Otherwise, the current request is narrowed to the entities
+// that aren't cached, and the processor calls CompleteCachedEntities while post-processing to merge the
+// cached entities into the response. processor identifies the calling processor.
+func ServeCachedEntities(rc *dispatcher.RequestContext, processor any, get func(proto.Message) proto.Message) dispatcher.Outcome {
+	req, ok := rc.CurrentRequest.(*pbv2.ObservationRequest)
+	if !ok {
+		return dispatcher.Continue
+	}
+	entityReqs := SplitObservationRequest(req)
+	// Single-entity requests are cached as a whole.
+	if len(entityReqs) < 2 {
+		return dispatcher.Continue
+	}
+
+	cached := []*pbv2.ObservationResponse{}
+	missing := []string{}
+	for _, entity := range sortedSet(req.GetEntity().GetDcids()) {
+		if resp, ok := get(entityReqs[entity]).(*pbv2.ObservationResponse); ok && resp != nil {
+			cached = append(cached, resp)
+		} else {
+			missing = append(missing, entity)
+		}
+	}
+	if len(cached) == 0 {
+		return dispatcher.Continue
+	}
+	if len(missing) == 0 {
+		rc.CurrentResponse = merger.MergeMultiObservation(cached)
+		return dispatcher.Done
+	}
+
+	narrowed := proto.Clone(req).(*pbv2.ObservationRequest)
+	narrowed.Entity = &pbv2.DcidOrExpression{Dcids: missing}
+	rc.CurrentRequest = narrowed
+	rc.Context = context.WithValue(rc.Context, partialKey{processor}, &partialRequest{request: req, cached: cached})
+	return dispatcher.Continue
+}
+
+// CompleteCachedEntities caches the current response of an observation request by entity with put,
+// if put is not nil. If the request was narrowed by ServeCachedEntities, the cached entities are merged
+// into the response and the request is restored.
+func CompleteCachedEntities(rc *dispatcher.RequestContext, processor any, put func(request, response proto.Message)) {
+	req, ok := rc.CurrentRequest.(*pbv2.ObservationRequest)
+	if !ok {
+		return
+	}
+	resp, ok := rc.CurrentResponse.(*pbv2.ObservationResponse)
+	if !ok || resp == nil {
+		return
+	}
+
+	partial, isPartial := rc.Context.Value(partialKey{processor}).(*partialRequest)
+	entityReqs := SplitObservationRequest(req)
+	// Requests that weren't narrowed are also cached as a whole, which covers single-entity requests.
+	if put != nil && (isPartial || len(entityReqs) > 1) {
+		for entity, entityResp := range SplitObservationResponse(req, resp) {
+			put(entityReqs[entity], entityResp)
+		}
+	}
+	if !isPartial {
+		return
+	}
+	rc.CurrentResponse = merger.MergeMultiObservation(append([]*pbv2.ObservationResponse{resp}, partial.cached...))
+	rc.CurrentRequest = partial.request
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cacheutil
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func observationRequest(entities ...string) *pbv2.ObservationRequest {
+	return &pbv2.ObservationRequest{
+		Variable: &pbv2.DcidOrExpression{Dcids: []string{"Count_Person"}},
+		Entity:   &pbv2.DcidOrExpression{Dcids: entities},
+		Select:   []string{"variable", "entity", "date", "value"},
+		Date:     "LATEST",
+	}
+}
+
+// observationResponse returns a response with one observation per entity and a facet per entity.
+func observationResponse(entities ...string) *pbv2.ObservationResponse {
+	resp := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{
+			"Count_Person": {ByEntity: map[string]*pbv2.EntityObservation{}},
+		},
+		Facets: map[string]*pb.Facet{},
+	}
+	for _, entity := range entities {
+		resp.ByVariable["Count_Person"].ByEntity[entity] = &pbv2.EntityObservation{
+			OrderedFacets: []*pbv2.FacetObservation{{
+				FacetId:      "facet-" + entity,
+				Observations: []*pb.PointStat{{Date: "2020", Value: proto.Float64(1)}},
+			}},
+		}
+		resp.Facets["facet-"+entity] = &pb.Facet{ImportName: entity}
+	}
+	return resp
+}
+
+func TestSplitObservationResponse(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		req  *pbv2.ObservationRequest
+		resp *pbv2.ObservationResponse
+		want map[string]*pbv2.ObservationResponse
+	}{
+		{
+			name: "by entity",
+			req:  observationRequest("geoId/06", "geoId/05"),
+			resp: observationResponse("geoId/06", "geoId/05"),
+			want: map[string]*pbv2.ObservationResponse{
+				"geoId/05": observationResponse("geoId/05"),
+				"geoId/06": observationResponse("geoId/06"),
+			},
+		},
+		{
+			name: "entity without data",
+			req:  observationRequest("geoId/06", "geoId/05"),
+			resp: observationResponse("geoId/06"),
+			want: map[string]*pbv2.ObservationResponse{
+				"geoId/05": {ByVariable: map[string]*pbv2.VariableObservation{"Count_Person": {}}},
+				"geoId/06": observationResponse("geoId/06"),
+			},
+		},
+		{
+			name: "unreferenced facet",
+			req:  observationRequest("geoId/06", "geoId/05"),
+			resp: func() *pbv2.ObservationResponse {
+				resp := observationResponse("geoId/06", "geoId/05")
+				resp.Facets["other"] = &pb.Facet{}
+				return resp
+			}(),
+		},
+		{
+			name: "expression",
+			req: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"Count_Person"}},
+				Entity:   &pbv2.DcidOrExpression{Expression: "country/USA<-containedInPlace+{typeOf:State}"},
+			},
+			resp: observationResponse("geoId/06"),
+		},
+	} {
+		got := SplitObservationResponse(tc.req, tc.resp)
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
+
+func TestCachedEntities(t *testing.T) {
+	// Cache of single-entity responses keyed by entity.
+	cache := map[string]proto.Message{}
+	get := func(request proto.Message) proto.Message {
+		return cache[request.(*pbv2.ObservationRequest).GetEntity().GetDcids()[0]]
+	}
+	put := func(request, response proto.Message) {
+		cache[request.(*pbv2.ObservationRequest).GetEntity().GetDcids()[0]] = response
+	}
+	processor := &struct{}{}
+
+	// A first request caches its entities.
+	req := observationRequest("geoId/06", "geoId/05")
+	rc := &dispatcher.RequestContext{Context: context.Background(), Type: dispatcher.TypeObservation, CurrentRequest: req}
+	if outcome := ServeCachedEntities(rc, processor, get); outcome != dispatcher.Continue {
+		t.Fatalf("ServeCachedEntities = %v, want Continue", outcome)
+	}
+	rc.CurrentResponse = observationResponse("geoId/06", "geoId/05")
+	CompleteCachedEntities(rc, processor, put)
+	if len(cache) != 2 {
+		t.Fatalf("Cached entities = %d, want 2", len(cache))
+	}
+
+	// An overlapping request only fetches the other entity.
+	req = observationRequest("geoId/06", "geoId/07")
+	rc = &dispatcher.RequestContext{Context: context.Background(), Type: dispatcher.TypeObservation, CurrentRequest: req}
+	if outcome := ServeCachedEntities(rc, processor, get); outcome != dispatcher.Continue {
+		t.Fatalf("ServeCachedEntities = %v, want Continue", outcome)
+	}
+	if diff := cmp.Diff(rc.CurrentRequest, observationRequest("geoId/07"), protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected narrowed request diff %v", diff)
+	}
+	rc.CurrentResponse = observationResponse("geoId/07")
+	CompleteCachedEntities(rc, processor, put)
+	if diff := cmp.Diff(rc.CurrentResponse, observationResponse("geoId/06", "geoId/07"), protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected merged response diff %v", diff)
+	}
+	if rc.CurrentRequest != req {
+		t.Errorf("CurrentRequest = %v, want the original request", rc.CurrentRequest)
+	}
+
+	// A request for cached entities is served from the cache.
+	rc = &dispatcher.RequestContext{
+		Context:        context.Background(),
+		Type:           dispatcher.TypeObservation,
+		CurrentRequest: observationRequest("geoId/05", "geoId/07"),
+	}
+	if outcome := ServeCachedEntities(rc, processor, get); outcome != dispatcher.Done {
+		t.Fatalf("ServeCachedEntities = %v, want Done", outcome)
+	}
+	if diff := cmp.Diff(rc.CurrentResponse, observationResponse("geoId/05", "geoId/07"), protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected cached response diff %v", diff)
+	}
+}
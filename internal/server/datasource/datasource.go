@@ -17,7 +17,9 @@ package datasource
 import (
 	"context"
 
+	pb "github.com/datacommonsorg/mixer/internal/proto"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/util"
 )
 
 // DataSourceType represents the type of data source.
@@ -38,4 +40,21 @@ type DataSource interface {
 	Observation(context.Context, *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error)
 	NodeSearch(context.Context, *pbv2.NodeSearchRequest) (*pbv2.NodeSearchResponse, error)
 	Resolve(context.Context, *pbv2.ResolveRequest) (*pbv2.ResolveResponse, error)
+	Event(context.Context, *pbv2.EventRequest) (*pbv2.EventResponse, error)
+	Sparql(context.Context, *pb.SparqlRequest) (*pb.QueryResponse, error)
+}
+
+// Reloader is implemented by data sources that keep a snapshot of their data, e.g. the provenances of a SQL database.
+type Reloader interface {
+	// Reload refreshes the snapshot after the served data changes.
+	Reload(ctx context.Context) error
+}
+
+// ObservationExistence is implemented by data sources that know which entities and variables they have observations for,
+// so that observation requests are only routed to them for those.
+type ObservationExistence interface {
+	// Existence returns the entity and variable pairs with observations.
+	// Variables with observations are also keyed without an entity.
+	// Returns nil if the pairs are not known.
+	Existence() map[util.EntityVariable]struct{}
 }
@@ -0,0 +1,353 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dsutil has the conversions to Observation responses shared by the data sources
+// that read observations row by row, i.e. the SQL and mock data sources.
+package dsutil
+
+import (
+	"sort"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/protobuf/proto"
+)
+
+// LatestDate is the date of Observation requests for the latest observations.
+const LatestDate = "LATEST"
+
+// Select options for Observation.
+const (
+	selectEntity   = "entity"
+	selectVariable = "variable"
+	selectDate     = "date"
+	selectValue    = "value"
+	selectFacet    = "facet"
+)
+
+// QueryOptions represents the selected fields of an Observation request.
+type QueryOptions struct {
+	Entity   bool
+	Variable bool
+	Date     bool
+	Value    bool
+	Facet    bool
+}
+
+// SelectFieldsToQueryOptions returns the options of the select fields of an Observation request.
+func SelectFieldsToQueryOptions(selectFields []string) QueryOptions {
+	var qo QueryOptions
+	for _, field := range selectFields {
+		switch field {
+		case selectEntity:
+			qo.Entity = true
+		case selectVariable:
+			qo.Variable = true
+		case selectDate:
+			qo.Date = true
+		case selectValue:
+			qo.Value = true
+		case selectFacet:
+			qo.Facet = true
+		}
+	}
+	return qo
+}
+
+// IsExistence returns whether a request only needs to know which entities have data for which variables.
+func (qo QueryOptions) IsExistence(variables []string) bool {
+	return !qo.Date && !qo.Value && (!qo.Facet || len(variables) == 0)
+}
+
+// IsEmpty returns whether a request that isn't an existence check has an empty response.
+// Observations require both date and value to be selected.
+func (qo QueryOptions) IsEmpty(variables, entities []string, entityExpr string) bool {
+	return qo.Date != qo.Value || len(variables) == 0 || (len(entities) == 0 && entityExpr == "")
+}
+
+// ObservationDate returns the date of the observations to read for a request.
+// Facet queries for specific entities summarize all dates.
+func (qo QueryOptions) ObservationDate(entities []string, date string) string {
+	if !qo.Date && !qo.Value && (len(entities) > 0 || date == LatestDate) {
+		return ""
+	}
+	return date
+}
+
+// FacetSeries holds the observations of a single variable and entity for a single facet.
+type FacetSeries struct {
+	Variable     string
+	Entity       string
+	FacetID      string
+	Facet        *pb.Facet
+	Observations []*pb.PointStat
+}
+
+// variableEntity is a variable and entity combination.
+type variableEntity struct {
+	variable string
+	entity   string
+}
+
+// FacetSeriesBuilder groups observations by variable, entity and facet.
+// Series whose facets don't match the filter are dropped.
+type FacetSeriesBuilder struct {
+	filter      *pbv2.FacetFilter
+	seriesByKey map[facetSeriesKey]*FacetSeries
+	series      []*FacetSeries
+}
+
+type facetSeriesKey struct {
+	variable string
+	entity   string
+	facetID  string
+}
+
+// NewFacetSeriesBuilder returns a FacetSeriesBuilder that keeps the series whose facets match the filter.
+func NewFacetSeriesBuilder(filter *pbv2.FacetFilter) *FacetSeriesBuilder {
+	return &FacetSeriesBuilder{
+		filter:      filter,
+		seriesByKey: map[facetSeriesKey]*FacetSeries{},
+	}
+}
+
+// Add adds an observation to the series of its variable, entity and facet.
+// Observations are expected to be added by date (ascending).
+func (b *FacetSeriesBuilder) Add(variable, entity, date string, value float64, facet *pb.Facet) {
+	facetID := util.GetFacetID(facet)
+	key := facetSeriesKey{variable: variable, entity: entity, facetID: facetID}
+	series, ok := b.seriesByKey[key]
+	if !ok {
+		if !util.ShouldIncludeFacet(b.filter, facet) {
+			return
+		}
+		series = &FacetSeries{
+			Variable: variable,
+			Entity:   entity,
+			FacetID:  facetID,
+			Facet:    facet,
+		}
+		b.seriesByKey[key] = series
+		b.series = append(b.series, series)
+	}
+	series.Observations = append(series.Observations, &pb.PointStat{
+		Date:  date,
+		Value: proto.Float64(value),
+	})
+}
+
+// Series returns the series in the order they were first added.
+// If the date is LATEST, only the latest observation of each series is kept.
+func (b *FacetSeriesBuilder) Series(date string) []*FacetSeries {
+	if date == LatestDate {
+		for _, series := range b.series {
+			series.Observations = series.Observations[len(series.Observations)-1:]
+		}
+	}
+	return b.series
+}
+
+// NewObservationResponse returns an empty ObservationResponse with an entry for each variable.
+func NewObservationResponse(variables []string) *pbv2.ObservationResponse {
+	response := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{},
+		Facets:     map[string]*pb.Facet{},
+	}
+	for _, variable := range variables {
+		response.ByVariable[variable] = &pbv2.VariableObservation{
+			ByEntity: map[string]*pbv2.EntityObservation{},
+		}
+	}
+	return response
+}
+
+// FacetSeriesToObservationResponse converts the facet series of a request to its ObservationResponse proto.
+//
+// If date and value are selected, the observations are included and filtered by valueFilter,
+// and all requested entities are in the response.
+// Otherwise only the facet summaries (count, earliest and latest dates) are included,
+// and for an entity expression the facets of all child places are merged under an empty entity.
+func FacetSeriesToObservationResponse(
+	qo QueryOptions,
+	variables []string,
+	entities []string,
+	entityExpr string,
+	allSeries []*FacetSeries,
+	valueFilter *util.ValueFilter,
+) *pbv2.ObservationResponse {
+	if qo.Date && qo.Value {
+		response := facetSeriesToObservationResponse(variables, allSeries, true /*includeObs*/)
+		util.FilterObservationsByValue(response, valueFilter)
+		// Attach all requested entity dcids to response.
+		for _, variableObs := range response.ByVariable {
+			for _, entity := range entities {
+				if _, ok := variableObs.ByEntity[entity]; !ok {
+					variableObs.ByEntity[entity] = &pbv2.EntityObservation{}
+				}
+			}
+		}
+		return response
+	}
+
+	response := facetSeriesToObservationResponse(variables, allSeries, false /*includeObs*/)
+	if entityExpr != "" {
+		return mergeEntityFacets(variables, response)
+	}
+	return response
+}
+
+// facetSeriesToObservationResponse converts facet series to an ObservationResponse proto.
+// The facets of each variable and entity are ordered by rank.
+// If includeObs is false, only the facet summaries (count, earliest and latest dates) are included.
+func facetSeriesToObservationResponse(variables []string, allSeries []*FacetSeries, includeObs bool) *pbv2.ObservationResponse {
+	response := NewObservationResponse(variables)
+
+	seriesByVariableEntity := map[variableEntity][]*FacetSeries{}
+	for _, series := range allSeries {
+		key := variableEntity{variable: series.Variable, entity: series.Entity}
+		seriesByVariableEntity[key] = append(seriesByVariableEntity[key], series)
+	}
+
+	for key, seriesList := range seriesByVariableEntity {
+		variableObs, ok := response.ByVariable[key.variable]
+		if !ok {
+			continue
+		}
+		placeVariableFacets := []*pb.PlaceVariableFacet{}
+		facetIDToFacetObs := map[string]*pbv2.FacetObservation{}
+		for _, series := range seriesList {
+			observations := series.Observations
+			facetObs := &pbv2.FacetObservation{
+				FacetId:      series.FacetID,
+				ObsCount:     int32(len(observations)),
+				EarliestDate: observations[0].Date,
+				LatestDate:   observations[len(observations)-1].Date,
+			}
+			if includeObs {
+				facetObs.Observations = observations
+			}
+			facetIDToFacetObs[series.FacetID] = facetObs
+			placeVariableFacets = append(placeVariableFacets, &pb.PlaceVariableFacet{
+				Facet:        series.Facet,
+				ObsCount:     facetObs.ObsCount,
+				EarliestDate: facetObs.EarliestDate,
+				LatestDate:   facetObs.LatestDate,
+			})
+			response.Facets[series.FacetID] = series.Facet
+		}
+
+		sort.Sort(ranking.FacetByRank(placeVariableFacets))
+		entityObs := &pbv2.EntityObservation{}
+		for _, placeVariableFacet := range placeVariableFacets {
+			entityObs.OrderedFacets = append(entityObs.OrderedFacets, facetIDToFacetObs[util.GetFacetID(placeVariableFacet.Facet)])
+		}
+		variableObs.ByEntity[key.entity] = entityObs
+	}
+
+	return response
+}
+
+// mergeEntityFacets merges the facets of all entities of each variable under an empty entity.
+// This matches the V2 response for facets of contained-in entity expressions, where:
+// - ObsCount is the number of entities that have data for a facet.
+// - EarliestDate and LatestDate are the earliest and latest dates of any entity for a facet.
+func mergeEntityFacets(variables []string, response *pbv2.ObservationResponse) *pbv2.ObservationResponse {
+	merged := NewObservationResponse(variables)
+	merged.Facets = response.Facets
+
+	for variable, variableObs := range merged.ByVariable {
+		// Sort entities for a stable facet order.
+		byEntity := response.ByVariable[variable].GetByEntity()
+		entities := make([]string, 0, len(byEntity))
+		for entity := range byEntity {
+			entities = append(entities, entity)
+		}
+		sort.Strings(entities)
+
+		seenFacets := map[string]*pbv2.FacetObservation{}
+		mergedObs := &pbv2.EntityObservation{}
+		for _, entity := range entities {
+			for _, item := range byEntity[entity].GetOrderedFacets() {
+				facetObs, ok := seenFacets[item.FacetId]
+				if !ok {
+					facetObs = &pbv2.FacetObservation{
+						FacetId:      item.FacetId,
+						EarliestDate: item.EarliestDate,
+						LatestDate:   item.LatestDate,
+					}
+					seenFacets[item.FacetId] = facetObs
+					mergedObs.OrderedFacets = append(mergedObs.OrderedFacets, facetObs)
+				}
+				facetObs.ObsCount++
+				if item.EarliestDate < facetObs.EarliestDate {
+					facetObs.EarliestDate = item.EarliestDate
+				}
+				if item.LatestDate > facetObs.LatestDate {
+					facetObs.LatestDate = item.LatestDate
+				}
+			}
+		}
+		variableObs.ByEntity[""] = mergedObs
+	}
+
+	return merged
+}
+
+// EntityVariablesToObservationResponse converts the variables of each entity to an existence ObservationResponse proto.
+// If variables is empty, all variables of the entities are included.
+// Otherwise only the specified variables are included.
+func EntityVariablesToObservationResponse(variables []string, entityVariables map[string][]string) *pbv2.ObservationResponse {
+	response := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{},
+	}
+	for _, variable := range variables {
+		response.ByVariable[variable] = &pbv2.VariableObservation{
+			ByEntity: map[string]*pbv2.EntityObservation{},
+		}
+	}
+
+	for entity, entityVars := range entityVariables {
+		for _, variable := range entityVars {
+			variableObs, ok := response.ByVariable[variable]
+			if !ok {
+				if len(variables) > 0 {
+					continue
+				}
+				variableObs = &pbv2.VariableObservation{
+					ByEntity: map[string]*pbv2.EntityObservation{},
+				}
+				response.ByVariable[variable] = variableObs
+			}
+			variableObs.ByEntity[entity] = &pbv2.EntityObservation{}
+		}
+	}
+
+	return response
+}
+
+// ObservationFacet returns the facet of an observation of a provenance.
+// The provenance facet holds the import name and url of the provenance, and can be nil.
+func ObservationFacet(provenance *pb.Facet, unit, scalingFactor, measurementMethod, observationPeriod string) *pb.Facet {
+	facet := &pb.Facet{}
+	if provenance != nil {
+		facet = proto.Clone(provenance).(*pb.Facet)
+	}
+	facet.Unit = unit
+	facet.ScalingFactor = scalingFactor
+	facet.MeasurementMethod = measurementMethod
+	facet.ObservationPeriod = observationPeriod
+	return facet
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsutil
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestFacetSeriesToObservationResponse(t *testing.T) {
+	census := &pb.Facet{ImportName: "CensusPEP"}
+	censusID := util.GetFacetID(census)
+	wdi := &pb.Facet{ImportName: "WorldDevelopmentIndicators"}
+	wdiID := util.GetFacetID(wdi)
+	series := func(date string) []*FacetSeries {
+		builder := NewFacetSeriesBuilder(&pbv2.FacetFilter{FacetIds: []string{censusID, wdiID}})
+		builder.Add("Count_Person", "geoId/01", "2019", 1, census)
+		builder.Add("Count_Person", "geoId/01", "2020", 2, census)
+		builder.Add("Count_Person", "geoId/02", "2018", 3, wdi)
+		// Series of facets that don't match the filter are dropped.
+		builder.Add("Count_Person", "geoId/02", "2020", 4, &pb.Facet{ImportName: "Other"})
+		return builder.Series(date)
+	}
+
+	for _, c := range []struct {
+		name       string
+		qo         QueryOptions
+		entities   []string
+		entityExpr string
+		date       string
+		want       *pbv2.ObservationResponse
+	}{
+		{
+			name:     "observations",
+			qo:       QueryOptions{Variable: true, Entity: true, Date: true, Value: true},
+			entities: []string{"geoId/01", "geoId/02", "geoId/03"},
+			date:     LatestDate,
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"Count_Person": {ByEntity: map[string]*pbv2.EntityObservation{
+						"geoId/01": {OrderedFacets: []*pbv2.FacetObservation{{
+							FacetId:      censusID,
+							ObsCount:     1,
+							EarliestDate: "2020",
+							LatestDate:   "2020",
+							Observations: []*pb.PointStat{{Date: "2020", Value: proto.Float64(2)}},
+						}}},
+						"geoId/02": {OrderedFacets: []*pbv2.FacetObservation{{
+							FacetId:      wdiID,
+							ObsCount:     1,
+							EarliestDate: "2018",
+							LatestDate:   "2018",
+							Observations: []*pb.PointStat{{Date: "2018", Value: proto.Float64(3)}},
+						}}},
+						// All requested entities are in the response.
+						"geoId/03": {},
+					}},
+				},
+				Facets: map[string]*pb.Facet{censusID: census, wdiID: wdi},
+			},
+		},
+		{
+			name:       "facets of an entity expression",
+			qo:         QueryOptions{Variable: true, Entity: true, Facet: true},
+			entityExpr: "geoId/06<-containedInPlace+{typeOf:County}",
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"Count_Person": {ByEntity: map[string]*pbv2.EntityObservation{
+						"": {OrderedFacets: []*pbv2.FacetObservation{
+							{FacetId: censusID, ObsCount: 1, EarliestDate: "2019", LatestDate: "2020"},
+							{FacetId: wdiID, ObsCount: 1, EarliestDate: "2018", LatestDate: "2018"},
+						}},
+					}},
+				},
+				Facets: map[string]*pb.Facet{censusID: census, wdiID: wdi},
+			},
+		},
+	} {
+		got := FacetSeriesToObservationResponse(c.qo, []string{"Count_Person"}, c.entities, c.entityExpr, series(c.date), nil)
+		if diff := cmp.Diff(got, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", c.name, diff)
+		}
+	}
+}
+
+func TestObservationDate(t *testing.T) {
+	facets := QueryOptions{Variable: true, Entity: true, Facet: true}
+	observations := QueryOptions{Variable: true, Entity: true, Date: true, Value: true}
+	for _, c := range []struct {
+		qo       QueryOptions
+		entities []string
+		date     string
+		want     string
+	}{
+		// Facet queries for specific entities summarize all dates.
+		{facets, []string{"geoId/06"}, "2020", ""},
+		{facets, nil, LatestDate, ""},
+		{facets, nil, "2020", "2020"},
+		{observations, []string{"geoId/06"}, LatestDate, LatestDate},
+	} {
+		if got := c.qo.ObservationDate(c.entities, c.date); got != c.want {
+			t.Errorf("ObservationDate(%v, %v, %s) = %s, want %s", c.qo, c.entities, c.date, got, c.want)
+		}
+	}
+}
@@ -16,143 +16,315 @@ package datasources
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
 
 	"github.com/datacommonsorg/mixer/internal/merger"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
 	"github.com/datacommonsorg/mixer/internal/server/datasource"
+	"github.com/datacommonsorg/mixer/internal/translator/sparql"
+	"github.com/datacommonsorg/mixer/internal/util"
 
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 )
 
 // DataSources struct uses underlying data sources to respond to API requests.
 type DataSources struct {
+	// Sources in order of priority.
 	sources []*datasource.DataSource
+	// Policies keyed by data source id.
+	// Sources without a policy use the default policy.
+	policies map[string]*SourcePolicy
+	// Policy used to merge the responses of the sources.
+	mergePolicy merger.MergePolicy
+	// Routing of requests to sources. Requests are sent to all sources if nil.
+	routing *Routing
+	// Responses of sources that don't paginate themselves, kept for the later pages of Node requests.
+	nodeResults *nodeResults
 }
 
-func NewDataSources(sources []*datasource.DataSource) *DataSources {
-	return &DataSources{sources: sources}
+// NewDataSources creates DataSources for the given sources.
+// Policies can be nil, in which case every source is required and sources are preferred in the given order.
+// Routing can be nil, in which case every request is sent to all sources.
+func NewDataSources(
+	sources []*datasource.DataSource,
+	policies map[string]*SourcePolicy,
+	mergePolicy merger.MergePolicy,
+	routing *Routing,
+) *DataSources {
+	ds := &DataSources{policies: policies, mergePolicy: mergePolicy, routing: routing, nodeResults: newNodeResults()}
+	ds.sources = append([]*datasource.DataSource{}, sources...)
+	sort.SliceStable(ds.sources, func(i, j int) bool {
+		return ds.policy(*ds.sources[i]).Priority > ds.policy(*ds.sources[j]).Priority
+	})
+	return ds
 }
 
-func (ds *DataSources) Node(ctx context.Context, in *pbv2.NodeRequest) (*pbv2.NodeResponse, error) {
-	errGroup, errCtx := errgroup.WithContext(ctx)
-	dsRespChan := []chan *pbv2.NodeResponse{}
-
-	for _, source := range ds.sources {
-		src := *source
-		respChan := make(chan *pbv2.NodeResponse, 1)
-		errGroup.Go(func() error {
-			defer close(respChan)
-			resp, err := src.Node(errCtx, in)
-			if err != nil {
-				return err
-			}
-			respChan <- resp
-			return nil
-		})
-		dsRespChan = append(dsRespChan, respChan)
-	}
-
-	if err := errGroup.Wait(); err != nil {
-		return nil, err
+// policy returns the policy for a source.
+func (ds *DataSources) policy(source datasource.DataSource) *SourcePolicy {
+	if policy, ok := ds.policies[source.Id()]; ok {
+		return policy
 	}
+	return defaultSourcePolicy
+}
 
-	allResp := []*pbv2.NodeResponse{}
-	for _, respChan := range dsRespChan {
-		allResp = append(allResp, <-respChan)
+// route returns the sources a request is routed to and the sub-request of each of them keyed by source id.
+func route[R any](
+	ds *DataSources,
+	in R,
+	subRequest func(datasource.DataSource, R) (R, bool),
+) ([]*datasource.DataSource, map[string]R) {
+	sources := []*datasource.DataSource{}
+	requests := map[string]R{}
+	for _, source := range ds.sources {
+		if req, ok := subRequest(*source, in); ok {
+			sources = append(sources, source)
+			requests[(*source).Id()] = req
+		}
 	}
-
-	return merger.MergeMultiNode(allResp)
+	return sources, requests
 }
 
-func (ds *DataSources) Observation(ctx context.Context, in *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
+// fanOut calls the sources concurrently and returns the responses of the sources that succeeded, in source order.
+// Failures of required sources fail the call.
+// Failures of best-effort sources are logged and reported, and their responses are skipped.
+func fanOut[T any](
+	ctx context.Context,
+	ds *DataSources,
+	sources []*datasource.DataSource,
+	call func(context.Context, datasource.DataSource) (T, error),
+) ([]T, error) {
 	errGroup, errCtx := errgroup.WithContext(ctx)
-	dsRespChan := []chan *pbv2.ObservationResponse{}
+	responses := make([]T, len(sources))
+	errs := make([]error, len(sources))
 
-	for _, source := range ds.sources {
-		src := *source
-		respChan := make(chan *pbv2.ObservationResponse, 1)
+	for i, source := range sources {
+		i, src := i, *source
+		policy := ds.policy(src)
 		errGroup.Go(func() error {
-			defer close(respChan)
-			resp, err := src.Observation(errCtx, in)
+			callCtx := errCtx
+			if policy.Timeout > 0 {
+				var cancel context.CancelFunc
+				callCtx, cancel = context.WithTimeout(errCtx, policy.Timeout)
+				defer cancel()
+			}
+			resp, err := call(callCtx, src)
 			if err != nil {
-				return err
+				if policy.Required {
+					return err
+				}
+				log.Printf("Best-effort data source %s failed: %v", src.Id(), err)
+				errs[i] = err
+				return nil
 			}
-			respChan <- resp
+			responses[i] = resp
 			return nil
 		})
-		dsRespChan = append(dsRespChan, respChan)
 	}
 
 	if err := errGroup.Wait(); err != nil {
 		return nil, err
 	}
 
-	allResp := []*pbv2.ObservationResponse{}
-	for _, respChan := range dsRespChan {
-		allResp = append(allResp, <-respChan)
+	allResp := []T{}
+	failedSources := []string{}
+	for i, source := range sources {
+		if errs[i] != nil {
+			failedSources = append(failedSources, (*source).Id())
+			continue
+		}
+		allResp = append(allResp, responses[i])
 	}
-
-	return merger.MergeMultiObservation(allResp), nil
+	if len(failedSources) > 0 {
+		if len(allResp) == 0 {
+			return nil, fmt.Errorf("all data sources failed: %v", errs)
+		}
+		ReportFailedSources(ctx, failedSources)
+	}
+	return allResp, nil
 }
 
-func (ds *DataSources) NodeSearch(ctx context.Context, in *pbv2.NodeSearchRequest) (*pbv2.NodeSearchResponse, error) {
-	errGroup, errCtx := errgroup.WithContext(ctx)
-	dsRespChan := []chan *pbv2.NodeSearchResponse{}
+// Node pages the responses of each source independently, with at most limit nodes for each query node by each source.
+// Requests without a limit get the whole response of each source, or its first page for sources that paginate themselves.
+// The next_token of the response holds the cursors of all sources with more pages.
+// Best-effort sources that fail are not paged further, and are reported as failed on every later page.
+func (ds *DataSources) Node(ctx context.Context, in *pbv2.NodeRequest) (*pbv2.NodeResponse, error) {
+	cursors, err := decodeNodeCursors(in.GetNextToken())
+	if err != nil {
+		return nil, err
+	}
+	limit := int(in.GetLimit())
 
-	for _, source := range ds.sources {
-		src := *source
-		respChan := make(chan *pbv2.NodeSearchResponse, 1)
-		errGroup.Go(func() error {
-			defer close(respChan)
-			resp, err := src.NodeSearch(errCtx, in)
+	sources, reqs := route(ds, in, ds.routing.nodeRequest)
+	nextCursors := nodeCursors{}
+	if cursors != nil {
+		// Only sources with more pages are in the cursors of a next_token.
+		pagedSources := []*datasource.DataSource{}
+		for _, source := range sources {
+			if cursor, ok := cursors[(*source).Id()]; ok && cursor != nil {
+				pagedSources = append(pagedSources, source)
+			}
+		}
+		sources = pagedSources
+		if failed := cursors.failed(); len(failed) > 0 {
+			ReportFailedSources(ctx, failed)
+			for _, id := range failed {
+				nextCursors[id] = nil
+			}
+		}
+	}
+
+	var mu sync.Mutex
+	allResp, err := fanOut(ctx, ds, sources, func(ctx context.Context, src datasource.DataSource) (*pbv2.NodeResponse, error) {
+		cursor := cursors[src.Id()]
+		req := proto.Clone(reqs[src.Id()]).(*pbv2.NodeRequest)
+		req.NextToken = cursor.GetId()
+		key, err := nodeResultKey(src.Id(), req)
+		if err != nil {
+			return nil, err
+		}
+		// Later pages of a source response are sliced from the kept response if there is one.
+		var resp, kept *pbv2.NodeResponse
+		if cursor.GetItem() > 0 {
+			resp = ds.nodeResults.get(key)
+		}
+		if resp == nil {
+			resp, err = src.Node(ctx, req)
 			if err != nil {
-				return err
+				mu.Lock()
+				nextCursors[src.Id()] = nil
+				mu.Unlock()
+				return nil, err
 			}
-			respChan <- resp
-			return nil
-		})
-		dsRespChan = append(dsRespChan, respChan)
+			if limit > 0 {
+				kept = proto.Clone(resp).(*pbv2.NodeResponse)
+			}
+		}
+		resp, nextCursor := pageNodeResponse(resp, cursor, limit)
+		if nextCursor.GetItem() > 0 {
+			// The next page is sliced from the same source response.
+			if kept != nil {
+				ds.nodeResults.put(key, kept)
+			}
+		} else if cursor.GetItem() > 0 {
+			ds.nodeResults.remove(key)
+		}
+		if nextCursor != nil {
+			mu.Lock()
+			nextCursors[src.Id()] = nextCursor
+			mu.Unlock()
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	merged, err := merger.MergeMultiNodeWithPolicy(allResp, ds.mergePolicy)
+	if err != nil {
+		return nil, err
+	}
+	merged.NextToken, err = nextCursors.encode()
+	if err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
 
-	if err := errGroup.Wait(); err != nil {
+func (ds *DataSources) Observation(ctx context.Context, in *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
+	// Date ranges and COMMON_LATEST are applied to the merged response, so that all sources
+	// agree on the common latest date. Sources are asked for all dates and values.
+	dateFilter, err := util.ParseDateFilter(in.GetDate())
+	if err != nil {
 		return nil, err
 	}
+	var valueFilter *util.ValueFilter
+	if dateFilter != nil {
+		if valueFilter, err = util.ParseValueFilter(in.GetValue()); err != nil {
+			return nil, err
+		}
+		if in, err = util.ObservationRequestForDateFilter(in); err != nil {
+			return nil, err
+		}
+	}
 
-	allResp := []*pbv2.NodeSearchResponse{}
-	for _, respChan := range dsRespChan {
-		allResp = append(allResp, <-respChan)
+	sources, reqs := route(ds, in, ds.routing.observationRequest)
+	allResp, err := fanOut(ctx, ds, sources, func(ctx context.Context, src datasource.DataSource) (*pbv2.ObservationResponse, error) {
+		return src.Observation(ctx, reqs[src.Id()])
+	})
+	if err != nil {
+		return nil, err
 	}
+	resp := merger.MergeMultiObservationWithPolicy(allResp, ds.mergePolicy)
+	util.FilterObservationsByDate(resp, dateFilter)
+	util.FilterObservationsByValue(resp, valueFilter)
+	return resp, nil
+}
 
-	return merger.MergeMultiNodeSearch(allResp)
+func (ds *DataSources) NodeSearch(ctx context.Context, in *pbv2.NodeSearchRequest) (*pbv2.NodeSearchResponse, error) {
+	allResp, err := fanOut(ctx, ds, ds.sources, func(ctx context.Context, src datasource.DataSource) (*pbv2.NodeSearchResponse, error) {
+		return src.NodeSearch(ctx, in)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return merger.MergeMultiNodeSearchWithPolicy(allResp, ds.mergePolicy)
 }
 
 func (ds *DataSources) Resolve(ctx context.Context, in *pbv2.ResolveRequest) (*pbv2.ResolveResponse, error) {
-	errGroup, errCtx := errgroup.WithContext(ctx)
-	dsRespChan := []chan *pbv2.ResolveResponse{}
-
-	for _, source := range ds.sources {
-		src := *source
-		respChan := make(chan *pbv2.ResolveResponse, 1)
-		errGroup.Go(func() error {
-			defer close(respChan)
-			resp, err := src.Resolve(errCtx, in)
-			if err != nil {
-				return err
-			}
-			respChan <- resp
-			return nil
-		})
-		dsRespChan = append(dsRespChan, respChan)
+	sources, reqs := route(ds, in, ds.routing.resolveRequest)
+	allResp, err := fanOut(ctx, ds, sources, func(ctx context.Context, src datasource.DataSource) (*pbv2.ResolveResponse, error) {
+		return src.Resolve(ctx, reqs[src.Id()])
+	})
+	if err != nil {
+		return nil, err
 	}
+	return merger.MergeMultiResolveWithPolicy(allResp, ds.mergePolicy), nil
+}
 
-	if err := errGroup.Wait(); err != nil {
+func (ds *DataSources) Event(ctx context.Context, in *pbv2.EventRequest) (*pbv2.EventResponse, error) {
+	allResp, err := fanOut(ctx, ds, ds.sources, func(ctx context.Context, src datasource.DataSource) (*pbv2.EventResponse, error) {
+		return src.Event(ctx, in)
+	})
+	if err != nil {
 		return nil, err
 	}
+	return merger.MergeMultiEvent(allResp), nil
+}
 
-	allResp := []*pbv2.ResolveResponse{}
-	for _, respChan := range dsRespChan {
-		allResp = append(allResp, <-respChan)
+func (ds *DataSources) Sparql(ctx context.Context, in *pb.SparqlRequest) (*pb.QueryResponse, error) {
+	_, _, opts, err := sparql.ParseQuery(in.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+	allResp, err := fanOut(ctx, ds, ds.sources, func(ctx context.Context, src datasource.DataSource) (*pb.QueryResponse, error) {
+		return src.Sparql(ctx, in)
+	})
+	if err != nil {
+		return nil, err
 	}
+	return merger.MergeMultiQuery(allResp, opts)
+}
 
-	return merger.MergeMultiResolve(allResp), nil
+// Reload refreshes the data that sources keep a snapshot of, after the served data changes.
+// See datasource.Reloader.
+func (ds *DataSources) Reload(ctx context.Context) error {
+	if ds == nil {
+		return nil
+	}
+	// Kept Node responses hold the old data.
+	ds.nodeResults.clear()
+	errs := []error{}
+	for _, source := range ds.sources {
+		if reloader, ok := (*source).(datasource.Reloader); ok {
+			if err := reloader.Reload(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", (*source).Id(), err))
+			}
+		}
+	}
+	return errors.Join(errs...)
 }
@@ -0,0 +1,493 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasources
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/datacommonsorg/mixer/internal/merger"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/datasource"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// fakeDataSource serves Node requests with a fixed response, an error or by blocking until the context is done.
+type fakeDataSource struct {
+	datasource.DataSource
+	sourceType datasource.DataSourceType
+	id         string
+	resp       *pbv2.NodeResponse
+	// Pages of the response keyed by next_token, used instead of resp if set.
+	pages map[string]*pbv2.NodeResponse
+	err   error
+	block bool
+	// The last Node request served, and the number of Node requests served.
+	gotNodeReq *pbv2.NodeRequest
+	nodeCalls  int
+	// Fixed response to Observation requests, and the last request served.
+	obsResp   *pbv2.ObservationResponse
+	gotObsReq *pbv2.ObservationRequest
+	// Entity and variable pairs with observations, unknown if nil.
+	existence map[util.EntityVariable]struct{}
+}
+
+func (ds *fakeDataSource) Type() datasource.DataSourceType {
+	return ds.sourceType
+}
+
+func (ds *fakeDataSource) Id() string {
+	return ds.id
+}
+
+func (ds *fakeDataSource) Existence() map[util.EntityVariable]struct{} {
+	return ds.existence
+}
+
+func (ds *fakeDataSource) Node(ctx context.Context, req *pbv2.NodeRequest) (*pbv2.NodeResponse, error) {
+	ds.gotNodeReq = req
+	ds.nodeCalls++
+	if ds.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	resp := ds.resp
+	if ds.pages != nil {
+		resp = ds.pages[req.GetNextToken()]
+	}
+	if ds.err != nil || resp == nil {
+		return nil, ds.err
+	}
+	return proto.Clone(resp).(*pbv2.NodeResponse), nil
+}
+
+func (ds *fakeDataSource) Observation(ctx context.Context, req *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
+	ds.gotObsReq = req
+	return proto.Clone(ds.obsResp).(*pbv2.ObservationResponse), nil
+}
+
+// fakeServerTransportStream captures the trailer set by a handler.
+type fakeServerTransportStream struct {
+	grpc.ServerTransportStream
+	trailer metadata.MD
+}
+
+func (s *fakeServerTransportStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func toSources(sources ...datasource.DataSource) []*datasource.DataSource {
+	result := []*datasource.DataSource{}
+	for i := range sources {
+		result = append(result, &sources[i])
+	}
+	return result
+}
+
+func TestNodeSourcePolicies(t *testing.T) {
+	sqlResp := &pbv2.NodeResponse{
+		Data: map[string]*pbv2.LinkedGraph{"myorg/var": {Properties: []string{"name"}}},
+	}
+	remoteResp := &pbv2.NodeResponse{
+		Data: map[string]*pbv2.LinkedGraph{"geoId/06": {Properties: []string{"name"}}},
+	}
+	remoteErr := errors.New("remote mixer unavailable")
+
+	for _, tc := range []struct {
+		name              string
+		sources           []*datasource.DataSource
+		policies          map[string]*SourcePolicy
+		want              *pbv2.NodeResponse
+		wantErr           bool
+		wantFailedSources []string
+	}{
+		{
+			name: "all sources succeed",
+			sources: toSources(
+				&fakeDataSource{sourceType: datasource.TypeSQL, id: "sql", resp: sqlResp},
+				&fakeDataSource{sourceType: datasource.TypeRemote, id: "remote", resp: remoteResp},
+			),
+			want: &pbv2.NodeResponse{
+				Data: map[string]*pbv2.LinkedGraph{
+					"myorg/var": {Properties: []string{"name"}},
+					"geoId/06":  {Properties: []string{"name"}},
+				},
+			},
+		},
+		{
+			name: "required source fails",
+			sources: toSources(
+				&fakeDataSource{sourceType: datasource.TypeSQL, id: "sql", resp: sqlResp},
+				&fakeDataSource{sourceType: datasource.TypeRemote, id: "remote", err: remoteErr},
+			),
+			wantErr: true,
+		},
+		{
+			name: "best-effort source fails",
+			sources: toSources(
+				&fakeDataSource{sourceType: datasource.TypeSQL, id: "sql", resp: sqlResp},
+				&fakeDataSource{sourceType: datasource.TypeRemote, id: "remote", err: remoteErr},
+			),
+			policies: map[string]*SourcePolicy{
+				"remote": {Required: false},
+			},
+			want:              sqlResp,
+			wantFailedSources: []string{"remote"},
+		},
+		{
+			name: "best-effort source times out",
+			sources: toSources(
+				&fakeDataSource{sourceType: datasource.TypeSQL, id: "sql", resp: sqlResp},
+				&fakeDataSource{sourceType: datasource.TypeRemote, id: "remote", block: true},
+			),
+			policies: map[string]*SourcePolicy{
+				"remote": {Required: false, Timeout: 10 * time.Millisecond},
+			},
+			want:              sqlResp,
+			wantFailedSources: []string{"remote"},
+		},
+		{
+			name: "policies of sources of the same type",
+			sources: toSources(
+				&fakeDataSource{sourceType: datasource.TypeRemote, id: "remote-custom", resp: sqlResp},
+				&fakeDataSource{sourceType: datasource.TypeRemote, id: "remote", err: remoteErr},
+			),
+			policies: map[string]*SourcePolicy{
+				"remote":        {Required: false},
+				"remote-custom": {Required: true},
+			},
+			want:              sqlResp,
+			wantFailedSources: []string{"remote"},
+		},
+		{
+			name: "all best-effort sources fail",
+			sources: toSources(
+				&fakeDataSource{sourceType: datasource.TypeRemote, id: "remote", err: remoteErr},
+			),
+			policies: map[string]*SourcePolicy{
+				"remote": {Required: false},
+			},
+			wantErr: true,
+		},
+	} {
+		stream := &fakeServerTransportStream{}
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+		ctx, failedSources := WithFailedSources(ctx)
+
+		ds := NewDataSources(tc.sources, tc.policies, merger.MergePolicyUnion, nil)
+		got, err := ds.Node(ctx, &pbv2.NodeRequest{})
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("Node (%s) = nil error, want error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Node error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+		if diff := cmp.Diff(failedSources.IDs(), tc.wantFailedSources, cmpopts.EquateEmpty()); diff != "" {
+			t.Errorf("Unexpected failed sources diff (%s) %v", tc.name, diff)
+		}
+		if len(tc.wantFailedSources) > 0 {
+			if diff := cmp.Diff(stream.trailer.Get(FailedSourcesTrailerKey), tc.wantFailedSources); diff != "" {
+				t.Errorf("Unexpected trailer diff (%s) %v", tc.name, diff)
+			}
+		}
+	}
+}
+
+func TestNodeMergePolicies(t *testing.T) {
+	newSources := func() []*datasource.DataSource {
+		return toSources(
+			&fakeDataSource{sourceType: datasource.TypeSpanner, id: "spanner", resp: &pbv2.NodeResponse{
+				Data: map[string]*pbv2.LinkedGraph{
+					"var": {Properties: []string{"name", "typeOf"}},
+				},
+			}},
+			&fakeDataSource{sourceType: datasource.TypeSQL, id: "sql", resp: &pbv2.NodeResponse{
+				Data: map[string]*pbv2.LinkedGraph{
+					"var":       {Properties: []string{"name"}},
+					"myorg/var": {Properties: []string{"name"}},
+				},
+			}},
+		)
+	}
+	customWins := map[string]*SourcePolicy{
+		"sql": {Required: true, Priority: 1},
+	}
+
+	for _, tc := range []struct {
+		name        string
+		policies    map[string]*SourcePolicy
+		mergePolicy merger.MergePolicy
+		want        *pbv2.NodeResponse
+	}{
+		{
+			name:        "first non-empty in source order",
+			mergePolicy: merger.MergePolicyFirstNonEmpty,
+			want: &pbv2.NodeResponse{
+				Data: map[string]*pbv2.LinkedGraph{
+					"var":       {Properties: []string{"name", "typeOf"}},
+					"myorg/var": {Properties: []string{"name"}},
+				},
+			},
+		},
+		{
+			name:        "custom data wins",
+			policies:    customWins,
+			mergePolicy: merger.MergePolicyFirstNonEmpty,
+			want: &pbv2.NodeResponse{
+				Data: map[string]*pbv2.LinkedGraph{
+					"var":       {Properties: []string{"name"}},
+					"myorg/var": {Properties: []string{"name"}},
+				},
+			},
+		},
+	} {
+		ds := NewDataSources(newSources(), tc.policies, tc.mergePolicy, nil)
+		got, err := ds.Node(context.Background(), &pbv2.NodeRequest{})
+		if err != nil {
+			t.Fatalf("Node error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
+
+func TestParseSourcePolicies(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		bestEffort string
+		timeouts   string
+		priorities string
+		want       map[string]*SourcePolicy
+		wantErr    bool
+	}{
+		{
+			name: "empty",
+			want: map[string]*SourcePolicy{},
+		},
+		{
+			name:       "best effort and timeouts",
+			bestEffort: "remote-api.datacommons.org",
+			timeouts:   "remote-api.datacommons.org=2s, sql-custom=500ms",
+			want: map[string]*SourcePolicy{
+				"remote-api.datacommons.org": {Required: false, Timeout: 2 * time.Second},
+				"sql-custom":                 {Required: true, Timeout: 500 * time.Millisecond},
+			},
+		},
+		{
+			name:       "priorities",
+			priorities: "sql-custom=10, spanner-dc=-1",
+			want: map[string]*SourcePolicy{
+				"sql-custom": {Required: true, Priority: 10},
+				"spanner-dc": {Required: true, Priority: -1},
+			},
+		},
+		{
+			name:       "invalid priority",
+			priorities: "sql=high",
+			wantErr:    true,
+		},
+		{
+			name:     "invalid timeout",
+			timeouts: "remote",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid duration",
+			timeouts: "remote=soon",
+			wantErr:  true,
+		},
+	} {
+		got, err := ParseSourcePolicies(tc.bestEffort, tc.timeouts, tc.priorities)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("ParseSourcePolicies (%s) = nil error, want error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("ParseSourcePolicies error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
+
+func TestObservationDateFilter(t *testing.T) {
+	// series returns the observations of a variable for entities keyed by date, in a single facet.
+	series := func(facetID string, entityDates map[string]map[string]float64) *pbv2.ObservationResponse {
+		resp := &pbv2.ObservationResponse{
+			ByVariable: map[string]*pbv2.VariableObservation{
+				"Count_Person": {ByEntity: map[string]*pbv2.EntityObservation{}},
+			},
+			Facets: map[string]*pb.Facet{facetID: {ImportName: facetID}},
+		}
+		for entity, values := range entityDates {
+			facetObs := &pbv2.FacetObservation{FacetId: facetID}
+			for _, date := range []string{"2019", "2020", "2021", "2022"} {
+				if value, ok := values[date]; ok {
+					facetObs.Observations = append(facetObs.Observations, &pb.PointStat{Date: date, Value: proto.Float64(value)})
+				}
+			}
+			facetObs.ObsCount = int32(len(facetObs.Observations))
+			facetObs.EarliestDate = facetObs.Observations[0].GetDate()
+			facetObs.LatestDate = facetObs.Observations[len(facetObs.Observations)-1].GetDate()
+			resp.ByVariable["Count_Person"].ByEntity[entity] = &pbv2.EntityObservation{
+				OrderedFacets: []*pbv2.FacetObservation{facetObs},
+			}
+		}
+		return resp
+	}
+	// The sql source alone has a common latest date of 2022, but most entities only have data for 2020.
+	sqlSource := &fakeDataSource{sourceType: datasource.TypeSQL, id: "sql", obsResp: series("sql", map[string]map[string]float64{
+		"geoId/01": {"2020": 1, "2022": 2},
+		"geoId/02": {"2020": 3, "2022": 4},
+	})}
+	remoteSource := &fakeDataSource{sourceType: datasource.TypeRemote, id: "remote", obsResp: series("remote", map[string]map[string]float64{
+		"geoId/03": {"2019": 5, "2020": 6},
+		"geoId/04": {"2020": 7},
+		"geoId/05": {"2020": 8, "2021": 9},
+	})}
+	ds := NewDataSources(toSources(sqlSource, remoteSource), nil, merger.MergePolicyUnion, nil)
+	request := func(date, value string) *pbv2.ObservationRequest {
+		return &pbv2.ObservationRequest{
+			Variable: &pbv2.DcidOrExpression{Dcids: []string{"Count_Person"}},
+			Entity:   &pbv2.DcidOrExpression{Expression: "country/USA<-containedInPlace+{typeOf:State}"},
+			Date:     date,
+			Value:    value,
+			Select:   []string{"variable", "entity", "date", "value"},
+		}
+	}
+
+	for _, tc := range []struct {
+		name string
+		in   *pbv2.ObservationRequest
+		want *pbv2.ObservationResponse
+		// Entities left without observations.
+		wantEmpty []string
+	}{
+		{
+			name: "common latest date",
+			in:   request(util.CommonLatestDate, ""),
+			want: series("remote", map[string]map[string]float64{
+				"geoId/01": {"2020": 1},
+				"geoId/02": {"2020": 3},
+				"geoId/03": {"2020": 6},
+				"geoId/04": {"2020": 7},
+				"geoId/05": {"2020": 8},
+			}),
+		},
+		{
+			name: "common latest date with value filter",
+			in:   request(util.CommonLatestDate, ">=6"),
+			want: series("remote", map[string]map[string]float64{
+				"geoId/03": {"2020": 6},
+				"geoId/04": {"2020": 7},
+				"geoId/05": {"2020": 8},
+			}),
+			wantEmpty: []string{"geoId/01", "geoId/02"},
+		},
+		{
+			name: "date range",
+			in:   request("2021..", ""),
+			want: series("remote", map[string]map[string]float64{
+				"geoId/01": {"2022": 2},
+				"geoId/02": {"2022": 4},
+				"geoId/05": {"2021": 9},
+			}),
+			wantEmpty: []string{"geoId/03", "geoId/04"},
+		},
+	} {
+		for _, entity := range tc.wantEmpty {
+			tc.want.ByVariable["Count_Person"].ByEntity[entity] = &pbv2.EntityObservation{}
+		}
+		got, err := ds.Observation(context.Background(), tc.in)
+		if err != nil {
+			t.Fatalf("Observation error (%s): %v", tc.name, err)
+		}
+		// Sources are asked for all dates and values.
+		for _, src := range []*fakeDataSource{sqlSource, remoteSource} {
+			if src.gotObsReq.GetDate() != "" || src.gotObsReq.GetValue() != "" {
+				t.Errorf("Observation (%s) sent date %q and value %q to %s, want all", tc.name, src.gotObsReq.GetDate(), src.gotObsReq.GetValue(), src.id)
+			}
+		}
+		// Facet ids of the sources differ, so compare observations only.
+		for _, entityObs := range got.GetByVariable()["Count_Person"].GetByEntity() {
+			for _, facetObs := range entityObs.GetOrderedFacets() {
+				facetObs.FacetId = "remote"
+			}
+		}
+		if diff := cmp.Diff(got.GetByVariable(), tc.want.GetByVariable(), protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+
+	if _, err := ds.Observation(context.Background(), &pbv2.ObservationRequest{
+		Variable: &pbv2.DcidOrExpression{Dcids: []string{"Count_Person"}},
+		Entity:   &pbv2.DcidOrExpression{Dcids: []string{"geoId/01"}},
+		Date:     "2020..2010",
+		Select:   []string{"variable", "entity", "date", "value"},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Observation with an invalid date range error = %v, want InvalidArgument", err)
+	}
+}
+
+// reloadingDataSource counts the reloads of its snapshot.
+type reloadingDataSource struct {
+	fakeDataSource
+	reloads int
+	err     error
+}
+
+func (ds *reloadingDataSource) Reload(ctx context.Context) error {
+	ds.reloads++
+	return ds.err
+}
+
+func TestReload(t *testing.T) {
+	sqlSource := &reloadingDataSource{fakeDataSource: fakeDataSource{sourceType: datasource.TypeSQL, id: "sql"}}
+	remoteSource := &fakeDataSource{sourceType: datasource.TypeRemote, id: "remote"}
+	ds := NewDataSources(toSources(sqlSource, remoteSource), nil, merger.MergePolicyUnion, nil)
+	if err := ds.Reload(context.Background()); err != nil {
+		t.Fatalf("Reload error: %v", err)
+	}
+	if sqlSource.reloads != 1 {
+		t.Errorf("Reload reloaded the sql source %d times, want 1", sqlSource.reloads)
+	}
+
+	sqlSource.err = errors.New("database is locked")
+	if err := ds.Reload(context.Background()); err == nil || err.Error() != "sql: database is locked" {
+		t.Errorf("Reload error = %v, want sql: database is locked", err)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasources
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv1 "github.com/datacommonsorg/mixer/internal/proto/v1"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/pagination"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxNodeResults is the max number of source responses kept by nodeResults.
+	maxNodeResults = 100
+	// nodeResultTTL is how long nodeResults keeps a source response for its later pages.
+	nodeResultTTL = 10 * time.Minute
+)
+
+// nodeCursors holds the cursor of each source for a Node request, keyed by source id.
+//
+// The cursor id is the next_token of the source page that is being read, empty for the first page,
+// and the cursor item is the number of nodes of that page that were already returned.
+// The composite next_token of a response holds one cursor group per source with more pages.
+//
+// A best-effort source that failed on an earlier page has a nil cursor, and a cursor group without cursors,
+// so that it's reported as failed on every later page instead of being dropped silently.
+type nodeCursors map[string]*pbv1.Cursor
+
+// decodeNodeCursors decodes the cursors of a composite next_token.
+// Returns nil for an empty token, i.e. the first page.
+func decodeNodeCursors(token string) (nodeCursors, error) {
+	if token == "" {
+		return nil, nil
+	}
+	info, err := pagination.Decode(token)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pagination token: %s", token)
+	}
+	cursors := nodeCursors{}
+	for _, group := range info.GetCursorGroups() {
+		if len(group.GetKeys()) != 1 || len(group.GetCursors()) > 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination token: %s", token)
+		}
+		if len(group.GetCursors()) == 0 {
+			cursors[group.GetKeys()[0]] = nil
+			continue
+		}
+		cursors[group.GetKeys()[0]] = group.GetCursors()[0]
+	}
+	return cursors, nil
+}
+
+// failed returns the sorted ids of the sources that failed on an earlier page.
+func (c nodeCursors) failed() []string {
+	ids := []string{}
+	for id, cursor := range c {
+		if cursor == nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// hasMore returns whether any source that didn't fail has more pages.
+func (c nodeCursors) hasMore() bool {
+	for _, cursor := range c {
+		if cursor != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// encode encodes the cursors into a composite next_token.
+// Returns an empty token if no source that didn't fail has more pages.
+func (c nodeCursors) encode() (string, error) {
+	if !c.hasMore() {
+		return "", nil
+	}
+	ids := []string{}
+	for id := range c {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	info := &pbv1.PaginationInfo{}
+	for _, id := range ids {
+		group := &pbv1.CursorGroup{Keys: []string{id}}
+		if c[id] != nil {
+			group.Cursors = []*pbv1.Cursor{c[id]}
+		}
+		info.CursorGroups = append(info.CursorGroups, group)
+	}
+	return util.EncodeProto(info)
+}
+
+// nodeResults keeps the responses of sources that don't paginate themselves while their pages are read,
+// so that later pages are sliced from the kept response instead of fetching it from the source again.
+// Responses are keyed by source id and request, and are dropped after their last page is read or after nodeResultTTL.
+// A response that is no longer kept, e.g. when pages are served by another server, is fetched again.
+type nodeResults struct {
+	mu      sync.Mutex
+	entries map[string]*nodeResult
+	// Returns the current time, overridden in tests.
+	now func() time.Time
+}
+
+type nodeResult struct {
+	resp      *pbv2.NodeResponse
+	expiresAt time.Time
+}
+
+func newNodeResults() *nodeResults {
+	return &nodeResults{entries: map[string]*nodeResult{}, now: time.Now}
+}
+
+// nodeResultKey returns the key of the response of a source to a request.
+func nodeResultKey(sourceID string, req *pbv2.NodeRequest) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	return sourceID + ":" + string(data), nil
+}
+
+// get returns a copy of the response kept for a key, or nil if there is none.
+func (r *nodeResults) get(key string) *pbv2.NodeResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	result, ok := r.entries[key]
+	if !ok {
+		return nil
+	}
+	if !r.now().Before(result.expiresAt) {
+		delete(r.entries, key)
+		return nil
+	}
+	return proto.Clone(result.resp).(*pbv2.NodeResponse)
+}
+
+// put keeps a response for a key. Expired responses are dropped first,
+// then the response that expires first if there are still maxNodeResults responses.
+func (r *nodeResults) put(key string, resp *pbv2.NodeResponse) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	if _, ok := r.entries[key]; !ok && len(r.entries) >= maxNodeResults {
+		oldestKey := ""
+		for k, result := range r.entries {
+			if !now.Before(result.expiresAt) {
+				delete(r.entries, k)
+			} else if oldestKey == "" || result.expiresAt.Before(r.entries[oldestKey].expiresAt) {
+				oldestKey = k
+			}
+		}
+		if len(r.entries) >= maxNodeResults {
+			delete(r.entries, oldestKey)
+		}
+	}
+	r.entries[key] = &nodeResult{resp: resp, expiresAt: now.Add(nodeResultTTL)}
+}
+
+// remove drops the response kept for a key.
+func (r *nodeResults) remove(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.entries, key)
+}
+
+// clear drops all kept responses, e.g. after the data of the sources changes.
+func (r *nodeResults) clear() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = map[string]*nodeResult{}
+}
+
+// pageNodeResponse trims a source response to the page that starts at the cursor,
+// with at most limit nodes for each query node, and returns the cursor of the next page of the source.
+// The next cursor is nil if the source has no more pages.
+// The response of the source is returned whole if limit is not positive.
+//
+// Nodes are ordered by property, then DCID and value, so the pages of a response are stable.
+// Sources that paginate themselves are read page by page through their own next_token.
+func pageNodeResponse(resp *pbv2.NodeResponse, cursor *pbv1.Cursor, limit int) (*pbv2.NodeResponse, *pbv1.Cursor) {
+	if resp == nil {
+		return resp, nil
+	}
+	if limit <= 0 {
+		sourceToken := resp.GetNextToken()
+		resp.NextToken = ""
+		if sourceToken == "" {
+			return resp, nil
+		}
+		return resp, &pbv1.Cursor{Id: sourceToken}
+	}
+	offset := int(cursor.GetItem())
+	more := false
+	for _, linkedGraph := range resp.GetData() {
+		props := []string{}
+		for prop := range linkedGraph.GetArcs() {
+			props = append(props, prop)
+		}
+		sort.Strings(props)
+
+		index := 0
+		for _, prop := range props {
+			nodes := linkedGraph.Arcs[prop].GetNodes()
+			sort.SliceStable(nodes, func(i, j int) bool {
+				if nodes[i].GetDcid() != nodes[j].GetDcid() {
+					return nodes[i].GetDcid() < nodes[j].GetDcid()
+				}
+				return nodes[i].GetValue() < nodes[j].GetValue()
+			})
+			page := []*pb.EntityInfo{}
+			for _, node := range nodes {
+				if index >= offset && index < offset+limit {
+					page = append(page, node)
+				}
+				index++
+			}
+			if len(page) == 0 {
+				delete(linkedGraph.Arcs, prop)
+			} else {
+				linkedGraph.Arcs[prop].Nodes = page
+			}
+		}
+		if index > offset+limit {
+			more = true
+		}
+	}
+
+	sourceToken := resp.GetNextToken()
+	resp.NextToken = ""
+	switch {
+	case more:
+		return resp, &pbv1.Cursor{Id: cursor.GetId(), Item: int32(offset + limit)}
+	case sourceToken != "":
+		return resp, &pbv1.Cursor{Id: sourceToken}
+	default:
+		return resp, nil
+	}
+}
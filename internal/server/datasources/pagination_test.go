@@ -0,0 +1,209 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/merger"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/datasource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func containedInResponse(nextToken string, dcids ...string) *pbv2.NodeResponse {
+	nodes := []*pb.EntityInfo{}
+	for _, dcid := range dcids {
+		nodes = append(nodes, &pb.EntityInfo{Dcid: dcid})
+	}
+	return &pbv2.NodeResponse{
+		Data: map[string]*pbv2.LinkedGraph{
+			"geoId/06": {Arcs: map[string]*pbv2.Nodes{"containedInPlace": {Nodes: nodes}}},
+		},
+		NextToken: nextToken,
+	}
+}
+
+func TestNodePagination(t *testing.T) {
+	// The SQL source returns all nodes at once, the remote source paginates itself.
+	sqlSource := &fakeDataSource{
+		sourceType: datasource.TypeSQL,
+		id:         "sql",
+		resp:       containedInResponse("", "sql/3", "sql/1", "sql/2"),
+	}
+	remoteSource := &fakeDataSource{
+		sourceType: datasource.TypeRemote,
+		id:         "remote",
+		pages: map[string]*pbv2.NodeResponse{
+			"":        containedInResponse("remote1", "remote/1", "remote/2"),
+			"remote1": containedInResponse("", "remote/3"),
+		},
+	}
+	ds := NewDataSources(toSources(sqlSource, remoteSource), nil, merger.MergePolicyUnion, nil)
+
+	got := [][]string{}
+	req := &pbv2.NodeRequest{Nodes: []string{"geoId/06"}, Property: "<-containedInPlace", Limit: 2}
+	for {
+		resp, err := ds.Node(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Node error: %v", err)
+		}
+		page := []string{}
+		for _, node := range resp.GetData()["geoId/06"].GetArcs()["containedInPlace"].GetNodes() {
+			page = append(page, node.GetDcid())
+		}
+		got = append(got, page)
+		if resp.GetNextToken() == "" {
+			break
+		}
+		if len(got) > 3 {
+			t.Fatalf("Node did not stop paginating: %v", got)
+		}
+		req.NextToken = resp.GetNextToken()
+	}
+
+	want := [][]string{
+		{"sql/1", "sql/2", "remote/1", "remote/2"},
+		{"sql/3", "remote/3"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected pages diff %v", diff)
+	}
+	// The later pages of the SQL source are sliced from its first response.
+	if sqlSource.nodeCalls != 1 {
+		t.Errorf("SQL source Node calls = %d, want 1", sqlSource.nodeCalls)
+	}
+
+	_, err := ds.Node(context.Background(), &pbv2.NodeRequest{
+		Nodes:     []string{"geoId/06"},
+		Property:  "<-containedInPlace",
+		NextToken: "invalid",
+	})
+	if err == nil {
+		t.Errorf("Node with invalid token = nil error, want error")
+	}
+}
+
+func TestNodePaginationFailedSource(t *testing.T) {
+	sqlSource := &fakeDataSource{
+		sourceType: datasource.TypeSQL,
+		id:         "sql",
+		resp:       containedInResponse("", "sql/1", "sql/2", "sql/3", "sql/4", "sql/5"),
+	}
+	remoteSource := &fakeDataSource{
+		sourceType: datasource.TypeRemote,
+		id:         "remote",
+		pages: map[string]*pbv2.NodeResponse{
+			"":        containedInResponse("remote1", "remote/1"),
+			"remote1": containedInResponse("", "remote/2"),
+		},
+	}
+	ds := NewDataSources(toSources(sqlSource, remoteSource), map[string]*SourcePolicy{
+		"remote": {Required: false},
+	}, merger.MergePolicyUnion, nil)
+
+	type page struct {
+		dcids         []string
+		failedSources []string
+	}
+	got := []page{}
+	req := &pbv2.NodeRequest{Nodes: []string{"geoId/06"}, Property: "<-containedInPlace", Limit: 2}
+	for {
+		ctx, failedSources := WithFailedSources(context.Background())
+		resp, err := ds.Node(ctx, req)
+		if err != nil {
+			t.Fatalf("Node error: %v", err)
+		}
+		dcids := []string{}
+		for _, node := range resp.GetData()["geoId/06"].GetArcs()["containedInPlace"].GetNodes() {
+			dcids = append(dcids, node.GetDcid())
+		}
+		got = append(got, page{dcids, failedSources.IDs()})
+		if resp.GetNextToken() == "" {
+			break
+		}
+		if len(got) > 3 {
+			t.Fatalf("Node did not stop paginating: %v", got)
+		}
+		req.NextToken = resp.GetNextToken()
+		// The remote source fails from the second page on.
+		remoteSource.err = errors.New("unavailable")
+		remoteSource.gotNodeReq = nil
+	}
+
+	// The remote source is reported as failed on every page after it failed, and isn't called again.
+	want := []page{
+		{[]string{"sql/1", "sql/2", "remote/1"}, nil},
+		{[]string{"sql/3", "sql/4"}, []string{"remote"}},
+		{[]string{"sql/5"}, []string{"remote"}},
+	}
+	if diff := cmp.Diff(got, want, cmp.AllowUnexported(page{}), cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("Unexpected pages diff %v", diff)
+	}
+	if remoteSource.gotNodeReq != nil {
+		t.Errorf("Failed source was called again: %v", remoteSource.gotNodeReq)
+	}
+}
+
+func TestNodePaginationWithoutLimit(t *testing.T) {
+	sqlSource := &fakeDataSource{
+		sourceType: datasource.TypeSQL,
+		id:         "sql",
+		resp:       containedInResponse("", "sql/1", "sql/2", "sql/3"),
+	}
+	remoteSource := &fakeDataSource{
+		sourceType: datasource.TypeRemote,
+		id:         "remote",
+		pages: map[string]*pbv2.NodeResponse{
+			"":        containedInResponse("remote1", "remote/1"),
+			"remote1": containedInResponse("", "remote/2"),
+		},
+	}
+	ds := NewDataSources(toSources(sqlSource, remoteSource), nil, merger.MergePolicyUnion, nil)
+
+	got := [][]string{}
+	req := &pbv2.NodeRequest{Nodes: []string{"geoId/06"}, Property: "<-containedInPlace"}
+	for {
+		resp, err := ds.Node(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Node error: %v", err)
+		}
+		page := []string{}
+		for _, node := range resp.GetData()["geoId/06"].GetArcs()["containedInPlace"].GetNodes() {
+			page = append(page, node.GetDcid())
+		}
+		got = append(got, page)
+		if resp.GetNextToken() == "" {
+			break
+		}
+		if len(got) > 3 {
+			t.Fatalf("Node did not stop paginating: %v", got)
+		}
+		req.NextToken = resp.GetNextToken()
+	}
+
+	// Sources are not truncated, and sources that paginate themselves are still read page by page.
+	want := [][]string{
+		{"sql/1", "sql/2", "sql/3", "remote/1"},
+		{"remote/2"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected pages diff %v", diff)
+	}
+}
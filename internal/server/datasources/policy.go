@@ -0,0 +1,143 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// FailedSourcesTrailerKey is the gRPC trailer key that lists the ids of best-effort sources
+// that failed while serving a request.
+const FailedSourcesTrailerKey = "x-failed-sources"
+
+// SourcePolicy defines how failures of a data source affect requests and how its data is ranked.
+type SourcePolicy struct {
+	// Failures of required sources fail the request.
+	// Failures of best-effort (not required) sources are reported and the request is served by the other sources.
+	Required bool
+	// Timeout for each call to the source. No timeout is applied if zero.
+	Timeout time.Duration
+	// Responses of sources with a higher priority are preferred when merging.
+	// Sources with the same priority keep the order in which they were added.
+	Priority int
+}
+
+// defaultSourcePolicy is used for sources without a policy.
+var defaultSourcePolicy = &SourcePolicy{Required: true}
+
+// ParseSourcePolicies parses source policies from flag values, keyed by source id.
+// bestEffort is a comma separated list of source ids, e.g. "remote-api.datacommons.org".
+// timeouts is a comma separated list of source id and timeout pairs, e.g. "remote-api.datacommons.org=2s,sql-custom=500ms".
+// priorities is a comma separated list of source id and priority pairs, e.g. "sql-custom=10,spanner-dc=5".
+func ParseSourcePolicies(bestEffort, timeouts, priorities string) (map[string]*SourcePolicy, error) {
+	policies := map[string]*SourcePolicy{}
+	getPolicy := func(sourceID string) *SourcePolicy {
+		if _, ok := policies[sourceID]; !ok {
+			policies[sourceID] = &SourcePolicy{Required: true}
+		}
+		return policies[sourceID]
+	}
+
+	for _, sourceID := range splitList(bestEffort) {
+		getPolicy(sourceID).Required = false
+	}
+
+	for _, part := range splitList(timeouts) {
+		sourceID, value, ok := splitPair(part)
+		if !ok {
+			return nil, fmt.Errorf("invalid source timeout: %s", part)
+		}
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout < 0 {
+			return nil, fmt.Errorf("invalid source timeout: %s", part)
+		}
+		getPolicy(sourceID).Timeout = timeout
+	}
+
+	for _, part := range splitList(priorities) {
+		sourceID, value, ok := splitPair(part)
+		if !ok {
+			return nil, fmt.Errorf("invalid source priority: %s", part)
+		}
+		priority, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source priority: %s", part)
+		}
+		getPolicy(sourceID).Priority = priority
+	}
+
+	return policies, nil
+}
+
+// splitPair splits a "key=value" pair.
+func splitPair(pair string) (string, string, bool) {
+	key, value, ok := strings.Cut(pair, "=")
+	return strings.TrimSpace(key), strings.TrimSpace(value), ok
+}
+
+// splitList splits a comma separated list and drops empty items.
+func splitList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// failedSourcesKey is the context key of the FailedSources of a request.
+type failedSourcesKey struct{}
+
+// FailedSources collects the ids of best-effort sources that failed while serving a request.
+type FailedSources struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+// WithFailedSources returns a context in which failed sources are collected into the returned FailedSources.
+func WithFailedSources(ctx context.Context) (context.Context, *FailedSources) {
+	failedSources := &FailedSources{}
+	return context.WithValue(ctx, failedSourcesKey{}, failedSources), failedSources
+}
+
+// IDs returns the ids of the failed sources.
+func (f *FailedSources) IDs() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string{}, f.ids...)
+}
+
+// ReportFailedSources records failed sources in the context and in the gRPC trailer.
+func ReportFailedSources(ctx context.Context, ids []string) {
+	if failedSources, ok := ctx.Value(failedSourcesKey{}).(*FailedSources); ok {
+		failedSources.mu.Lock()
+		failedSources.ids = append(failedSources.ids, ids...)
+		failedSources.mu.Unlock()
+	}
+	// Not every context is a gRPC server context (e.g. in tests), so only log failures to set the trailer.
+	if err := grpc.SetTrailer(ctx, metadata.Pairs(FailedSourcesTrailerKey, strings.Join(ids, ","))); err != nil {
+		log.Printf("Error setting failed sources trailer: %v", err)
+	}
+}
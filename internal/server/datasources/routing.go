@@ -0,0 +1,169 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasources
+
+import (
+	"fmt"
+	"strings"
+
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/datasource"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/protobuf/proto"
+)
+
+// Routing routes the DCIDs of requests to the data sources that can serve them.
+type Routing struct {
+	// DCID prefixes keyed by data source id.
+	// DCIDs with a prefix of a source are only sent to that source.
+	Prefixes map[string][]string
+}
+
+// ParseRoutingPrefixes parses DCID prefix rules from a flag value, keyed by source id.
+// prefixes is a comma separated list of source id and DCID prefix pairs, e.g. "sql-custom=myorg/,sql-custom=custom/".
+func ParseRoutingPrefixes(prefixes string) (map[string][]string, error) {
+	result := map[string][]string{}
+	for _, part := range splitList(prefixes) {
+		sourceID, prefix, ok := splitPair(part)
+		if !ok || prefix == "" {
+			return nil, fmt.Errorf("invalid source prefix: %s", part)
+		}
+		result[sourceID] = append(result[sourceID], prefix)
+	}
+	return result, nil
+}
+
+// allowed reports whether a DCID can be sent to a source according to the prefix rules.
+func (r *Routing) allowed(src datasource.DataSource, dcid string) bool {
+	owned := false
+	for sourceID, prefixes := range r.Prefixes {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(dcid, prefix) {
+				if sourceID == src.Id() {
+					return true
+				}
+				owned = true
+			}
+		}
+	}
+	return !owned
+}
+
+// nodeRequest returns the part of a node request that is routed to a source.
+// Returns false if no node is routed to the source.
+func (r *Routing) nodeRequest(src datasource.DataSource, in *pbv2.NodeRequest) (*pbv2.NodeRequest, bool) {
+	if r == nil || len(in.GetNodes()) == 0 {
+		return in, true
+	}
+	nodes := filterDcids(in.GetNodes(), func(node string) bool {
+		return r.allowed(src, node)
+	})
+	if len(nodes) == 0 {
+		return nil, false
+	}
+	if len(nodes) == len(in.GetNodes()) {
+		return in, true
+	}
+	out := proto.Clone(in).(*pbv2.NodeRequest)
+	out.Nodes = nodes
+	return out, true
+}
+
+// resolveRequest returns the part of a resolve request that is routed to a source.
+// Returns false if no node is routed to the source.
+func (r *Routing) resolveRequest(src datasource.DataSource, in *pbv2.ResolveRequest) (*pbv2.ResolveRequest, bool) {
+	if r == nil || len(in.GetNodes()) == 0 {
+		return in, true
+	}
+	nodes := filterDcids(in.GetNodes(), func(node string) bool {
+		return r.allowed(src, node)
+	})
+	if len(nodes) == 0 {
+		return nil, false
+	}
+	if len(nodes) == len(in.GetNodes()) {
+		return in, true
+	}
+	out := proto.Clone(in).(*pbv2.ResolveRequest)
+	out.Nodes = nodes
+	return out, true
+}
+
+// observationRequest returns the part of an observation request that is routed to a source.
+// Only variable and entity DCIDs are routed, formulas and entity expressions are sent to every source.
+// Sources that know their observations (see datasource.ObservationExistence) only get the variables and entities they have.
+// Returns false if no variable or no entity is routed to the source.
+func (r *Routing) observationRequest(src datasource.DataSource, in *pbv2.ObservationRequest) (*pbv2.ObservationRequest, bool) {
+	if r == nil {
+		return in, true
+	}
+	var existence map[util.EntityVariable]struct{}
+	if existenceSrc, ok := src.(datasource.ObservationExistence); ok {
+		existence = existenceSrc.Existence()
+	}
+	variables := in.GetVariable().GetDcids()
+	entities := in.GetEntity().GetDcids()
+
+	routedVariables := filterDcids(variables, func(variable string) bool {
+		if !r.allowed(src, variable) {
+			return false
+		}
+		if existence == nil {
+			return true
+		}
+		_, ok := existence[util.EntityVariable{V: variable}]
+		return ok
+	})
+	routedEntities := filterDcids(entities, func(entity string) bool {
+		if !r.allowed(src, entity) {
+			return false
+		}
+		if existence == nil || len(routedVariables) == 0 {
+			return true
+		}
+		for _, variable := range routedVariables {
+			if _, ok := existence[util.EntityVariable{E: entity, V: variable}]; ok {
+				return true
+			}
+		}
+		return false
+	})
+
+	if (len(variables) > 0 && len(routedVariables) == 0) || (len(entities) > 0 && len(routedEntities) == 0) {
+		return nil, false
+	}
+	if len(routedVariables) == len(variables) && len(routedEntities) == len(entities) {
+		return in, true
+	}
+	out := proto.Clone(in).(*pbv2.ObservationRequest)
+	if len(variables) > 0 {
+		out.Variable.Dcids = routedVariables
+	}
+	if len(entities) > 0 {
+		out.Entity.Dcids = routedEntities
+	}
+	return out, true
+}
+
+// filterDcids returns the DCIDs to keep, in order.
+func filterDcids(dcids []string, keep func(string) bool) []string {
+	result := []string{}
+	for _, dcid := range dcids {
+		if keep(dcid) {
+			result = append(result, dcid)
+		}
+	}
+	return result
+}
@@ -0,0 +1,213 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/merger"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/datasource"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestObservationRouting(t *testing.T) {
+	sqlSource := &fakeDataSource{sourceType: datasource.TypeSQL, id: "sql", existence: map[util.EntityVariable]struct{}{
+		{V: "myorg/var"}:                   {},
+		{E: "geoId/06", V: "myorg/var"}:    {},
+		{V: "Count_Person"}:                {},
+		{E: "geoId/08", V: "Count_Person"}: {},
+	}}
+	remoteSource := &fakeDataSource{sourceType: datasource.TypeRemote, id: "remote"}
+	routing := &Routing{
+		Prefixes: map[string][]string{
+			"sql": {"myorg/"},
+		},
+	}
+	request := func(variables, entities []string) *pbv2.ObservationRequest {
+		return &pbv2.ObservationRequest{
+			Variable: &pbv2.DcidOrExpression{Dcids: variables},
+			Entity:   &pbv2.DcidOrExpression{Dcids: entities},
+			Select:   []string{"variable", "entity", "value"},
+		}
+	}
+
+	for _, tc := range []struct {
+		name       string
+		src        datasource.DataSource
+		in         *pbv2.ObservationRequest
+		want       *pbv2.ObservationRequest
+		wantRouted bool
+	}{
+		{
+			name:       "custom variable to sql",
+			src:        sqlSource,
+			in:         request([]string{"myorg/var", "Count_Person"}, []string{"geoId/06", "geoId/08"}),
+			want:       request([]string{"myorg/var", "Count_Person"}, []string{"geoId/06", "geoId/08"}),
+			wantRouted: true,
+		},
+		{
+			name:       "custom variable not to remote",
+			src:        remoteSource,
+			in:         request([]string{"myorg/var", "Count_Person"}, []string{"geoId/06", "geoId/08"}),
+			want:       request([]string{"Count_Person"}, []string{"geoId/06", "geoId/08"}),
+			wantRouted: true,
+		},
+		{
+			name:       "only existing variables and entities to sql",
+			src:        sqlSource,
+			in:         request([]string{"Count_Person", "Median_Age_Person"}, []string{"geoId/06", "geoId/08"}),
+			want:       request([]string{"Count_Person"}, []string{"geoId/08"}),
+			wantRouted: true,
+		},
+		{
+			name: "nothing to sql",
+			src:  sqlSource,
+			in:   request([]string{"Median_Age_Person"}, []string{"geoId/06"}),
+		},
+		{
+			name: "nothing to remote",
+			src:  remoteSource,
+			in:   request([]string{"myorg/var"}, []string{"geoId/06"}),
+		},
+		{
+			name: "entity expression",
+			src:  sqlSource,
+			in: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"Count_Person"}},
+				Entity:   &pbv2.DcidOrExpression{Expression: "geoId/06<-containedInPlace+{typeOf:County}"},
+			},
+			want: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"Count_Person"}},
+				Entity:   &pbv2.DcidOrExpression{Expression: "geoId/06<-containedInPlace+{typeOf:County}"},
+			},
+			wantRouted: true,
+		},
+	} {
+		got, routed := routing.observationRequest(tc.src, tc.in)
+		if routed != tc.wantRouted {
+			t.Errorf("observationRequest (%s) routed = %t, want %t", tc.name, routed, tc.wantRouted)
+			continue
+		}
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+
+	// Requests are routed by the current existence of the source, e.g. after it is reloaded.
+	sqlSource.existence = map[util.EntityVariable]struct{}{
+		{V: "Median_Age_Person"}:                {},
+		{E: "geoId/06", V: "Median_Age_Person"}: {},
+	}
+	in := request([]string{"Median_Age_Person"}, []string{"geoId/06"})
+	if got, routed := routing.observationRequest(sqlSource, in); !routed || !proto.Equal(got, in) {
+		t.Errorf("observationRequest after reload = %v, %t, want %v, true", got, routed, in)
+	}
+}
+
+func TestNodeRouting(t *testing.T) {
+	sqlSource := &fakeDataSource{
+		sourceType: datasource.TypeSQL,
+		id:         "sql",
+		resp: &pbv2.NodeResponse{
+			Data: map[string]*pbv2.LinkedGraph{"myorg/var": {Properties: []string{"name"}}},
+		},
+	}
+	remoteSource := &fakeDataSource{
+		sourceType: datasource.TypeRemote,
+		id:         "remote",
+		resp: &pbv2.NodeResponse{
+			Data: map[string]*pbv2.LinkedGraph{"geoId/06": {Properties: []string{"name"}}},
+		},
+	}
+	routing := &Routing{
+		Prefixes: map[string][]string{
+			"sql": {"myorg/"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name          string
+		nodes         []string
+		wantSQLReq    *pbv2.NodeRequest
+		wantRemoteReq *pbv2.NodeRequest
+	}{
+		{
+			name:          "split nodes",
+			nodes:         []string{"myorg/var", "geoId/06"},
+			wantSQLReq:    &pbv2.NodeRequest{Nodes: []string{"myorg/var", "geoId/06"}, Property: "->name"},
+			wantRemoteReq: &pbv2.NodeRequest{Nodes: []string{"geoId/06"}, Property: "->name"},
+		},
+		{
+			name:       "custom nodes only",
+			nodes:      []string{"myorg/var"},
+			wantSQLReq: &pbv2.NodeRequest{Nodes: []string{"myorg/var"}, Property: "->name"},
+		},
+	} {
+		sqlSource.gotNodeReq, remoteSource.gotNodeReq = nil, nil
+		ds := NewDataSources(toSources(sqlSource, remoteSource), nil, merger.MergePolicyUnion, routing)
+		_, err := ds.Node(context.Background(), &pbv2.NodeRequest{Nodes: tc.nodes, Property: "->name"})
+		if err != nil {
+			t.Fatalf("Node error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(sqlSource.gotNodeReq, tc.wantSQLReq, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected sql request diff (%s) %v", tc.name, diff)
+		}
+		if diff := cmp.Diff(remoteSource.gotNodeReq, tc.wantRemoteReq, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected remote request diff (%s) %v", tc.name, diff)
+		}
+	}
+}
+
+func TestParseRoutingPrefixes(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		prefixes string
+		want     map[string][]string
+		wantErr  bool
+	}{
+		{
+			name:     "prefixes",
+			prefixes: "sql-custom=myorg/, sql-custom=custom/,remote-api.datacommons.org=dc/",
+			want: map[string][]string{
+				"sql-custom":                 {"myorg/", "custom/"},
+				"remote-api.datacommons.org": {"dc/"},
+			},
+		},
+		{
+			name:     "missing prefix",
+			prefixes: "sql-custom=",
+			wantErr:  true,
+		},
+	} {
+		got, err := ParseRoutingPrefixes(tc.prefixes)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("ParseRoutingPrefixes (%s) = nil error, want error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("ParseRoutingPrefixes error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
@@ -16,11 +16,16 @@ package dispatcher
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/datacommonsorg/mixer/internal/server/datasources"
 	"google.golang.org/protobuf/proto"
 
+	pb "github.com/datacommonsorg/mixer/internal/proto"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
 )
 
@@ -32,6 +37,8 @@ const (
 	TypeNodeSearch  RequestType = "NodeSearch"
 	TypeObservation RequestType = "Observation"
 	TypeResolve     RequestType = "Resolve"
+	TypeEvent       RequestType = "Event"
+	TypeSparql      RequestType = "Sparql"
 )
 
 // RequestContext holds the context for a given request.
@@ -48,6 +55,9 @@ type RequestContext struct {
 	OriginalRequest proto.Message
 	CurrentRequest  proto.Message
 	CurrentResponse proto.Message
+	// Ids of best-effort data sources that failed while serving the request.
+	// The response is partial if there are any.
+	FailedSources []string
 }
 
 // Outcome represents the result of a processing step.
@@ -68,6 +78,39 @@ type Processor interface {
 	PostProcess(*RequestContext) (Outcome, error)
 }
 
+// Finisher is implemented by processors that need to know when a request is done, whether it succeeded or not.
+type Finisher interface {
+	// Finish is called once the request is done, with the error returned for it if any.
+	// It's only called if the processor pre-processed the request.
+	Finish(rc *RequestContext, err error)
+}
+
+// Invalidator is implemented by processors that cache responses.
+type Invalidator interface {
+	// Invalidate drops the cached responses and caches new responses under a data version.
+	Invalidate(ctx context.Context, version string) error
+}
+
+// DataVersion identifies the data that responses are computed from.
+// Cached responses are namespaced by it so that they aren't served once the data changes.
+type DataVersion struct {
+	// Name of the branch Bigtable.
+	BranchTable string
+	// Time of the latest import into the SQL database.
+	SQLImportedAt string
+	// Hash of the V3 config.
+	ConfigHash string
+}
+
+// Key returns a short key that identifies the version, or an empty string if nothing is versioned.
+func (v *DataVersion) Key() string {
+	if *v == (DataVersion{}) {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(strings.Join([]string{v.BranchTable, v.SQLImportedAt, v.ConfigHash}, "\x00")))
+	return hex.EncodeToString(hash[:6])
+}
+
 // Dispatcher struct handles requests by dispatching requests to various processors and datasources as appropriate.
 type Dispatcher struct {
 	processors []*Processor
@@ -81,16 +124,43 @@ func NewDispatcher(processors []*Processor, sources *datasources.DataSources) *D
 	}
 }
 
-// handle handles a request lifecycle - pre-processing, core handling and post-processing.
-func (dispatcher *Dispatcher) handle(requestContext *RequestContext, handler func(context.Context, proto.Message) (proto.Message, error)) (proto.Message, error) {
+// Invalidate invalidates the responses cached by all processors for a new data version.
+// Data sources reload the data they keep a snapshot of first, so that new responses are computed from it.
+func (dispatcher *Dispatcher) Invalidate(ctx context.Context, version string) error {
+	errs := []error{}
+	if err := dispatcher.sources.Reload(ctx); err != nil {
+		errs = append(errs, err)
+	}
 	for _, processor := range dispatcher.processors {
+		if invalidator, ok := (*processor).(Invalidator); ok {
+			if err := invalidator.Invalidate(ctx, version); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// handle handles a request lifecycle - pre-processing, core handling and post-processing.
+//
+// If a processor is done while pre-processing, only the processors before it post-process its response.
+// This lets a processor in front of another cache, e.g. an in-memory cache in front of Redis, store the
+// responses served by that cache.
+func (dispatcher *Dispatcher) handle(requestContext *RequestContext, handler func(context.Context, proto.Message) (proto.Message, error)) (response proto.Message, err error) {
+	preProcessed := 0
+	defer func() {
+		dispatcher.finish(requestContext, preProcessed, err)
+	}()
+
+	for i, processor := range dispatcher.processors {
+		preProcessed = i + 1
 		outcome, err := (*processor).PreProcess(requestContext)
 		if err != nil {
 			return nil, err
 		}
 		switch outcome {
 		case Done:
-			return requestContext.CurrentResponse, nil
+			return dispatcher.postProcess(requestContext, i)
 		case Continue:
 			continue
 		default:
@@ -98,14 +168,30 @@ func (dispatcher *Dispatcher) handle(requestContext *RequestContext, handler fun
 		}
 	}
 
-	response, err := handler(requestContext.Context, requestContext.CurrentRequest)
+	ctx, failedSources := datasources.WithFailedSources(requestContext.Context)
+	response, err = handler(ctx, requestContext.CurrentRequest)
 	if err != nil {
 		return nil, err
 	}
 
 	requestContext.CurrentResponse = response
+	requestContext.FailedSources = failedSources.IDs()
 
-	for i := len(dispatcher.processors) - 1; i >= 0; i-- {
+	return dispatcher.postProcess(requestContext, len(dispatcher.processors))
+}
+
+// finish notifies the first n processors that the request is done, in reverse order.
+func (dispatcher *Dispatcher) finish(requestContext *RequestContext, n int, err error) {
+	for i := n - 1; i >= 0; i-- {
+		if finisher, ok := (*dispatcher.processors[i]).(Finisher); ok {
+			finisher.Finish(requestContext, err)
+		}
+	}
+}
+
+// postProcess runs the post-processing of the first n processors in reverse order.
+func (dispatcher *Dispatcher) postProcess(requestContext *RequestContext, n int) (proto.Message, error) {
+	for i := n - 1; i >= 0; i-- {
 		processor := dispatcher.processors[i]
 		outcome, err := (*processor).PostProcess(requestContext)
 		if err != nil {
@@ -176,6 +262,32 @@ func (dispatcher *Dispatcher) Resolve(ctx context.Context, in *pbv2.ResolveReque
 	return response.(*pbv2.ResolveResponse), nil
 }
 
+func (dispatcher *Dispatcher) Event(ctx context.Context, in *pbv2.EventRequest) (*pbv2.EventResponse, error) {
+	requestContext := newRequestContext(ctx, in, TypeEvent)
+
+	response, err := dispatcher.handle(requestContext, func(ctx context.Context, request proto.Message) (proto.Message, error) {
+		return dispatcher.sources.Event(ctx, request.(*pbv2.EventRequest))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pbv2.EventResponse), nil
+}
+
+func (dispatcher *Dispatcher) Sparql(ctx context.Context, in *pb.SparqlRequest) (*pb.QueryResponse, error) {
+	requestContext := newRequestContext(ctx, in, TypeSparql)
+
+	response, err := dispatcher.handle(requestContext, func(ctx context.Context, request proto.Message) (proto.Message, error) {
+		return dispatcher.sources.Sparql(ctx, request.(*pb.SparqlRequest))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.QueryResponse), nil
+}
+
 func newRequestContext(ctx context.Context, request proto.Message, requestType RequestType) *RequestContext {
 	return &RequestContext{
 		Context:         ctx,
@@ -0,0 +1,125 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// versionedProcessor records the versions it's invalidated with.
+type versionedProcessor struct {
+	versions []string
+	err      error
+}
+
+func (p *versionedProcessor) PreProcess(rc *RequestContext) (Outcome, error) {
+	return Continue, nil
+}
+
+func (p *versionedProcessor) PostProcess(rc *RequestContext) (Outcome, error) {
+	return Continue, nil
+}
+
+func (p *versionedProcessor) Invalidate(ctx context.Context, version string) error {
+	p.versions = append(p.versions, version)
+	return p.err
+}
+
+// plainProcessor doesn't cache responses.
+type plainProcessor struct{}
+
+func (p *plainProcessor) PreProcess(rc *RequestContext) (Outcome, error) {
+	return Continue, nil
+}
+
+func (p *plainProcessor) PostProcess(rc *RequestContext) (Outcome, error) {
+	return Continue, nil
+}
+
+func TestInvalidate(t *testing.T) {
+	failing := &versionedProcessor{err: errors.New("purge failed")}
+	succeeding := &versionedProcessor{}
+	var p1, p2, p3 Processor = failing, &plainProcessor{}, succeeding
+	dispatcher := NewDispatcher([]*Processor{&p1, &p2, &p3}, nil)
+
+	if err := dispatcher.Invalidate(context.Background(), "v1"); err == nil {
+		t.Errorf("Invalidate = nil error, want error")
+	}
+	// Processors are invalidated even if others fail.
+	for _, p := range []*versionedProcessor{failing, succeeding} {
+		if len(p.versions) != 1 || p.versions[0] != "v1" {
+			t.Errorf("Invalidated versions = %v, want [v1]", p.versions)
+		}
+	}
+}
+
+func TestDataVersionKey(t *testing.T) {
+	if got := (&DataVersion{}).Key(); got != "" {
+		t.Errorf("Key of empty version = %q, want empty", got)
+	}
+
+	v1 := &DataVersion{BranchTable: "branch_1", ConfigHash: "abc"}
+	v2 := &DataVersion{BranchTable: "branch_2", ConfigHash: "abc"}
+	if v1.Key() == "" || v1.Key() == v2.Key() {
+		t.Errorf("Keys = %q and %q, want distinct non-empty keys", v1.Key(), v2.Key())
+	}
+	if got := (&DataVersion{BranchTable: "branch_1", ConfigHash: "abc"}).Key(); got != v1.Key() {
+		t.Errorf("Key = %q, want %q", got, v1.Key())
+	}
+}
+
+// finishingProcessor records the errors it's finished with.
+type finishingProcessor struct {
+	plainProcessor
+	preErr error
+	errs   []error
+}
+
+func (p *finishingProcessor) PreProcess(rc *RequestContext) (Outcome, error) {
+	return Continue, p.preErr
+}
+
+func (p *finishingProcessor) Finish(rc *RequestContext, err error) {
+	p.errs = append(p.errs, err)
+}
+
+func TestFinish(t *testing.T) {
+	preErr := errors.New("pre-processing failed")
+	first := &finishingProcessor{}
+	failing := &finishingProcessor{preErr: preErr}
+	skipped := &finishingProcessor{}
+	var p1, p2, p3 Processor = first, failing, skipped
+	dispatcher := NewDispatcher([]*Processor{&p1, &p2, &p3}, nil)
+
+	if _, err := dispatcher.handle(newRequestContext(context.Background(), nil, TypeNode), nil); !errors.Is(err, preErr) {
+		t.Fatalf("handle error = %v, want %v", err, preErr)
+	}
+	// Only the processors that pre-processed the request are finished.
+	for _, tc := range []struct {
+		name      string
+		processor *finishingProcessor
+		want      []error
+	}{
+		{name: "first", processor: first, want: []error{preErr}},
+		{name: "failing", processor: failing, want: []error{preErr}},
+		{name: "skipped", processor: skipped},
+	} {
+		if len(tc.processor.errs) != len(tc.want) || (len(tc.want) > 0 && tc.processor.errs[0] != tc.want[0]) {
+			t.Errorf("Finish errors (%s) = %v, want %v", tc.name, tc.processor.errs, tc.want)
+		}
+	}
+}
@@ -127,8 +127,8 @@ func (s *Server) GetRelatedLocations(
 	if len(localResp.GetData()) == 0 &&
 		s.metadata.RemoteMixerDomain != "" {
 		remoteResp := &pb.GetRelatedLocationsResponse{}
-		if err := util.FetchRemote(
-			s.metadata, s.httpClient, "/v1/place/related", in, remoteResp); err != nil {
+		if err := util.FetchRemoteWithContext(
+			ctx, s.metadata, s.httpClient, "/v1/place/related", in, remoteResp); err != nil {
 			return nil, err
 		}
 		return remoteResp, nil
@@ -147,8 +147,8 @@ func (s *Server) GetLocationsRankings(
 	if len(localResp.GetData()) == 0 &&
 		s.metadata.RemoteMixerDomain != "" {
 		remoteResp := &pb.GetLocationsRankingsResponse{}
-		if err := util.FetchRemote(
-			s.metadata, s.httpClient, "/v1/place/ranking", in, remoteResp); err != nil {
+		if err := util.FetchRemoteWithContext(
+			ctx, s.metadata, s.httpClient, "/v1/place/ranking", in, remoteResp); err != nil {
 			return nil, err
 		}
 		return remoteResp, nil
@@ -312,6 +312,10 @@ func (s *Server) UpdateCache(
 		return nil, err
 	}
 	s.cachedata.Swap(newCache)
+	// The SQL database may have been reloaded.
+	if err := s.updateDataVersion(ctx); err != nil {
+		return nil, err
+	}
 	return &pb.UpdateCacheResponse{}, err
 }
 
@@ -34,23 +34,11 @@ import (
 func (s *Server) V2ResolveCore(
 	ctx context.Context, in *pbv2.ResolveRequest,
 ) (*pbv2.ResolveResponse, error) {
-	arcs, err := v2.ParseProperty(in.GetProperty())
+	inArc, outArc, err := v2.ParseResolveProperty(in.GetProperty())
 	if err != nil {
 		return nil, err
 	}
 
-	if len(arcs) != 2 {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"invalid property for resolving: %s", in.GetProperty())
-	}
-
-	inArc := arcs[0]
-	outArc := arcs[1]
-	if inArc.Out || !outArc.Out {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"invalid property for resolving: %s", in.GetProperty())
-	}
-
 	if inArc.SingleProp == "geoCoordinate" && outArc.SingleProp == "dcid" {
 		// Coordinate to ID:
 		// Example:
@@ -175,79 +163,39 @@ func (s *Server) V2NodeCore(
 func (s *Server) V2EventCore(
 	ctx context.Context, in *pbv2.EventRequest,
 ) (*pbv2.EventResponse, error) {
-	arcs, err := v2.ParseProperty(in.GetProperty())
+	eventProperty, err := v2.ParseEventProperty(in.GetProperty())
 	if err != nil {
 		return nil, err
 	}
 
-	// EventCollection.
+	// EventCollectionDate.
 	// Example:
-	//   <-location{typeOf:FireEvent, date:2020-10, area:3.1#6.2#Acre}'
-	if len(arcs) == 1 {
-		arc := arcs[0]
-		eventTypes, eventTypesOK := arc.Filter["typeOf"]
-		dates, datesOK := arc.Filter["date"]
-
-		if !arc.Out &&
-			arc.SingleProp == "location" &&
-			(len(arc.Filter) == 2 || len(arc.Filter) == 3) &&
-			eventTypesOK && len(eventTypes) == 1 &&
-			datesOK && len(dates) == 1 {
-			var eventFilterSpec *v1e.FilterSpec
-			hasEventFilter := len(arc.Filter) == 3
-			if hasEventFilter {
-				for k, v := range arc.Filter {
-					if k == "typeOf" || k == "date" {
-						continue
-					}
-					if len(v) != 1 {
-						return nil, status.Errorf(codes.InvalidArgument,
-							"invalid event filter in property: %s", in.GetProperty())
-					}
-					eventFilterSpec, err = v2e.ParseEventCollectionFilter(k, v[0])
-					if err != nil {
-						return nil, err
-					}
-				}
-			} else {
-				eventFilterSpec = nil
-			}
-
-			return v2e.EventCollection(
-				ctx,
-				s.store,
-				in.GetNode(),
-				eventTypes[0],
-				dates[0],
-				eventFilterSpec)
-		}
-
-		return nil, status.Errorf(codes.InvalidArgument,
-			"invalid property: %s", in.GetProperty())
+	//   <-location{typeOf:FireEvent}->date
+	if eventProperty.Date == "" {
+		return v2e.EventCollectionDate(
+			ctx,
+			s.store,
+			in.GetNode(),
+			eventProperty.EventType)
 	}
 
 	// EventCollection.
 	// Example:
-	//   <-location{typeOf:FireEvent}->date
-	if len(arcs) == 2 {
-		arc1, arc2 := arcs[0], arcs[1]
-		eventTypes, eventTypesOK := arc1.Filter["typeOf"]
-
-		if !arc1.Out &&
-			arc1.SingleProp == "location" &&
-			eventTypesOK && len(eventTypes) == 1 &&
-			arc2.Out &&
-			arc2.SingleProp == "date" {
-			return v2e.EventCollectionDate(
-				ctx,
-				s.store,
-				in.GetNode(),
-				eventTypes[0])
+	//   <-location{typeOf:FireEvent, date:2020-10, area:3.1#6.2#Acre}'
+	var eventFilterSpec *v1e.FilterSpec
+	if filter := eventProperty.Filter; filter != nil {
+		eventFilterSpec = &v1e.FilterSpec{
+			Prop:       filter.Prop,
+			Unit:       filter.Unit,
+			LowerLimit: filter.LowerLimit,
+			UpperLimit: filter.UpperLimit,
 		}
-
-		return nil, status.Errorf(codes.InvalidArgument,
-			"invalid property: %s", in.GetProperty())
 	}
-
-	return nil, nil
+	return v2e.EventCollection(
+		ctx,
+		s.store,
+		in.GetNode(),
+		eventProperty.EventType,
+		eventProperty.Date,
+		eventFilterSpec)
 }
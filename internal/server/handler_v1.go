@@ -150,7 +150,8 @@ func (s *Server) BulkPlaceInfo(
 		}
 		if len(in.Nodes) > 0 {
 			remoteResp := &pbv1.BulkPlaceInfoResponse{}
-			if err := util.FetchRemote(
+			if err := util.FetchRemoteWithContext(
+				ctx,
 				s.metadata,
 				s.httpClient,
 				"/v1/bulk/info/place",
@@ -204,7 +205,7 @@ func (s *Server) BulkVariableInfo(
 
 	if s.metadata.RemoteMixerDomain != "" {
 		errGroup.Go(func() error {
-			remoteResponse, err := remoteBulkVariableInfoFunc(s, in)
+			remoteResponse, err := remoteBulkVariableInfoFunc(errCtx, s, in)
 			if err != nil {
 				return err
 			}
@@ -254,7 +255,8 @@ func (s *Server) BulkVariableGroupInfo(
 			}
 		}
 		remoteResp := &pbv1.BulkVariableGroupInfoResponse{}
-		if err := util.FetchRemote(
+		if err := util.FetchRemoteWithContext(
+			ctx,
 			s.metadata,
 			s.httpClient,
 			"/v1/bulk/info/variable-group",
@@ -404,8 +406,8 @@ func (s *Server) BulkObservationDatesLinked(
 	if s.metadata.RemoteMixerDomain != "" {
 		errGroup.Go(func() error {
 			remoteResp := &pbv1.BulkObservationDatesLinkedResponse{}
-			err := util.FetchRemote(
-				s.metadata, s.httpClient, "/v1/bulk/observation-dates/linked", in, remoteResp)
+			err := util.FetchRemoteWithContext(
+				errCtx, s.metadata, s.httpClient, "/v1/bulk/observation-dates/linked", in, remoteResp)
 			if err != nil {
 				return err
 			}
@@ -448,7 +450,8 @@ func (s *Server) PlacePage(ctx context.Context, in *pbv1.PlacePageRequest) (
 	}
 	if len(localResp.GetStatVarSeries()) == 0 && s.metadata.RemoteMixerDomain != "" {
 		remoteResp := &pbv1.PlacePageResponse{}
-		if err := util.FetchRemote(
+		if err := util.FetchRemoteWithContext(
+			ctx,
 			s.metadata,
 			s.httpClient,
 			"/v1/internal/page/place",
@@ -472,7 +475,8 @@ func (s *Server) VariableAncestors(
 	}
 	if len(localResp.Ancestors) == 0 && s.metadata.RemoteMixerDomain != "" {
 		remoteResp := &pbv1.VariableAncestorsResponse{}
-		if err := util.FetchRemote(
+		if err := util.FetchRemoteWithContext(
+			ctx,
 			s.metadata,
 			s.httpClient,
 			"/v1/variable/ancestors",
@@ -535,7 +539,8 @@ func (s *Server) SearchStatVar(
 
 	remoteResp := &pb.SearchStatVarResponse{}
 	if s.metadata.RemoteMixerDomain != "" {
-		if err := util.FetchRemote(
+		if err := util.FetchRemoteWithContext(
+			ctx,
 			s.metadata,
 			s.httpClient,
 			"/v1/variable/search",
@@ -49,7 +49,7 @@ func (s *Server) V2Resolve(
 	if s.metadata.RemoteMixerDomain != "" {
 		errGroup.Go(func() error {
 			remoteResp := &pbv2.ResolveResponse{}
-			err := util.FetchRemote(s.metadata, s.httpClient, "/v2/resolve", in, remoteResp)
+			err := util.FetchRemoteWithContext(errCtx, s.metadata, s.httpClient, "/v2/resolve", in, remoteResp)
 			if err != nil {
 				return err
 			}
@@ -95,7 +95,7 @@ func (s *Server) V2Node(ctx context.Context, in *pbv2.NodeRequest) (
 		if s.metadata.RemoteMixerDomain != "" {
 			errGroup.Go(func() error {
 				remoteResp := &pbv2.NodeResponse{}
-				err := util.FetchRemote(s.metadata, s.httpClient, "/v2/node", in, remoteResp)
+				err := util.FetchRemoteWithContext(errCtx, s.metadata, s.httpClient, "/v2/node", in, remoteResp)
 				if err != nil {
 					return err
 				}
@@ -144,8 +144,8 @@ func (s *Server) V2Node(ctx context.Context, in *pbv2.NodeRequest) (
 
 				// Call remote.
 				remoteResp := &pbv2.NodeResponse{}
-				if err := util.FetchRemote(
-					s.metadata, s.httpClient, "/v2/node", in, remoteResp); err != nil {
+				if err := util.FetchRemoteWithContext(
+					errCtx, s.metadata, s.httpClient, "/v2/node", in, remoteResp); err != nil {
 					return err
 				}
 				remoteRespChan <- remoteResp
@@ -186,7 +186,7 @@ func (s *Server) V2Event(
 	if s.metadata.RemoteMixerDomain != "" {
 		errGroup.Go(func() error {
 			remoteResp := &pbv2.EventResponse{}
-			err := util.FetchRemote(s.metadata, s.httpClient, "/v2/event", in, remoteResp)
+			err := util.FetchRemoteWithContext(errCtx, s.metadata, s.httpClient, "/v2/event", in, remoteResp)
 			if err != nil {
 				return err
 			}
@@ -210,13 +210,18 @@ func (s *Server) V2Event(
 func (s *Server) V2Observation(
 	ctx context.Context, in *pbv2.ObservationRequest,
 ) (*pbv2.ObservationResponse, error) {
+	// Requests with a denominator fetch the numerators, which are divided once merged.
+	req, err := v2observation.NumeratorRequest(in)
+	if err != nil {
+		return nil, err
+	}
 	initialResp, err := v2observation.ObservationInternal(
 		ctx,
 		s.store,
 		s.cachedata.Load(),
 		s.metadata,
 		s.httpClient,
-		in)
+		req)
 	if err != nil {
 		return nil, err
 	}
@@ -226,15 +231,42 @@ func (s *Server) V2Observation(
 		s.cachedata.Load(),
 		s.metadata,
 		s.httpClient,
-		in,
+		req,
 		initialResp,
 	)
 	if err != nil {
 		return nil, err
 	}
 	// initialResp is preferred over any calculated response.
-	combinedResp := append([]*pbv2.ObservationResponse{initialResp}, calculatedResps...)
-	return merger.MergeMultiObservation(combinedResp), nil
+	combinedResp := merger.MergeMultiObservation(append([]*pbv2.ObservationResponse{initialResp}, calculatedResps...))
+	// Places that are still missing observations are aggregated from their child places, if requested.
+	aggregatedResp, err := v2observation.MaybeAggregateHoles(
+		ctx,
+		s.store,
+		s.cachedata.Load(),
+		s.metadata,
+		s.httpClient,
+		req,
+		combinedResp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if aggregatedResp != nil {
+		combinedResp = merger.MergeMultiObservation([]*pbv2.ObservationResponse{combinedResp, aggregatedResp})
+	}
+	if err := v2observation.MaybeNormalize(
+		ctx,
+		s.store,
+		s.cachedata.Load(),
+		s.metadata,
+		s.httpClient,
+		in,
+		combinedResp,
+	); err != nil {
+		return nil, err
+	}
+	return combinedResp, nil
 }
 
 // V2Sparql implements API for Mixer.V2Sparql.
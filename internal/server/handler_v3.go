@@ -18,6 +18,7 @@ package server
 import (
 	"context"
 
+	pb "github.com/datacommonsorg/mixer/internal/proto"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
 )
 
@@ -48,3 +49,17 @@ func (s *Server) V3Resolve(ctx context.Context, in *pbv2.ResolveRequest) (
 ) {
 	return s.dispatcher.Resolve(ctx, in)
 }
+
+// V3Event implements API for mixer.V3Event.
+func (s *Server) V3Event(ctx context.Context, in *pbv2.EventRequest) (
+	*pbv2.EventResponse, error,
+) {
+	return s.dispatcher.Event(ctx, in)
+}
+
+// V3Sparql implements API for mixer.V3Sparql.
+func (s *Server) V3Sparql(ctx context.Context, in *pb.SparqlRequest) (
+	*pb.QueryResponse, error,
+) {
+	return s.dispatcher.Sparql(ctx, in)
+}
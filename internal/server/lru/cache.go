@@ -0,0 +1,141 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lru provides an in-process cache of V3 responses for deployments without Redis.
+package lru
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Cache is a least recently used cache of protobuf responses bounded by their size in bytes.
+// It is safe for concurrent use.
+type Cache struct {
+	maxBytes int64
+	// Returns the current time, overridden in tests.
+	now func() time.Time
+
+	mu sync.Mutex
+	// Most recently used entries are at the front.
+	entries *list.List
+	byKey   map[string]*list.Element
+	stats   Stats
+}
+
+// Stats holds the counters of a cache.
+type Stats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+	// Number and total size in bytes of the cached entries.
+	Entries int64
+	Bytes   int64
+}
+
+type entry struct {
+	key      string
+	response proto.Message
+	size     int64
+	// Zero if the entry doesn't expire.
+	expiresAt time.Time
+}
+
+// NewCache creates a cache that holds at most maxBytes of responses.
+func NewCache(maxBytes int64) *Cache {
+	return &Cache{
+		maxBytes: maxBytes,
+		now:      time.Now,
+		entries:  list.New(),
+		byKey:    map[string]*list.Element{},
+	}
+}
+
+// Get returns a copy of the response cached for a key, or nil if there is none.
+func (c *Cache) Get(key string) proto.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.byKey[key]
+	if !ok {
+		c.stats.Misses++
+		return nil
+	}
+	e := element.Value.(*entry)
+	if !e.expiresAt.IsZero() && !c.now().Before(e.expiresAt) {
+		c.remove(element)
+		c.stats.Misses++
+		return nil
+	}
+	c.entries.MoveToFront(element)
+	c.stats.Hits++
+	// Callers may modify the response, e.g. in later processors.
+	return proto.Clone(e.response)
+}
+
+// Put caches a copy of a response for a key, evicting the least recently used entries to make room.
+// Entries don't expire if ttl is zero.
+// Responses larger than the cache are not cached.
+func (c *Cache) Put(key string, response proto.Message, ttl time.Duration) {
+	size := int64(len(key) + proto.Size(response))
+	if size > c.maxBytes {
+		return
+	}
+	e := &entry{key: key, response: proto.Clone(response), size: size}
+	if ttl > 0 {
+		e.expiresAt = c.now().Add(ttl)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.byKey[key]; ok {
+		c.remove(element)
+	}
+	for c.stats.Bytes+size > c.maxBytes {
+		c.remove(c.entries.Back())
+		c.stats.Evictions++
+	}
+	c.byKey[key] = c.entries.PushFront(e)
+	c.stats.Entries++
+	c.stats.Bytes += size
+}
+
+// Purge removes all entries.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries.Init()
+	c.byKey = map[string]*list.Element{}
+	c.stats.Entries = 0
+	c.stats.Bytes = 0
+}
+
+// Stats returns the current counters of the cache.
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// remove removes an entry, the caller must hold the lock.
+func (c *Cache) remove(element *list.Element) {
+	e := c.entries.Remove(element).(*entry)
+	delete(c.byKey, e.key)
+	c.stats.Entries--
+	c.stats.Bytes -= e.size
+}
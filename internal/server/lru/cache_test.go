@@ -0,0 +1,128 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lru
+
+import (
+	"testing"
+	"time"
+
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func nodeResponse(dcid string) *pbv2.NodeResponse {
+	return &pbv2.NodeResponse{Data: map[string]*pbv2.LinkedGraph{dcid: {Properties: []string{"name"}}}}
+}
+
+func entrySize(key string, response proto.Message) int64 {
+	return int64(len(key) + proto.Size(response))
+}
+
+func TestCacheEviction(t *testing.T) {
+	a, b, c := nodeResponse("a"), nodeResponse("b"), nodeResponse("c")
+	// Room for two entries.
+	cache := NewCache(2 * entrySize("a", a))
+
+	cache.Put("a", a, 0)
+	cache.Put("b", b, 0)
+	// Reading "a" makes "b" the least recently used entry.
+	if got := cache.Get("a"); got == nil {
+		t.Fatalf("Get(a) = nil, want response")
+	}
+	cache.Put("c", c, 0)
+
+	for _, tc := range []struct {
+		key  string
+		want proto.Message
+	}{
+		{"a", a},
+		{"b", nil},
+		{"c", c},
+	} {
+		if diff := cmp.Diff(cache.Get(tc.key), tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.key, diff)
+		}
+	}
+
+	want := Stats{Hits: 3, Misses: 1, Evictions: 1, Entries: 2, Bytes: 2 * entrySize("a", a)}
+	if diff := cmp.Diff(cache.Stats(), want); diff != "" {
+		t.Errorf("Unexpected stats diff %v", diff)
+	}
+}
+
+func TestCacheExpiration(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewCache(1 << 20)
+	cache.now = func() time.Time { return now }
+
+	cache.Put("short", nodeResponse("short"), time.Minute)
+	cache.Put("long", nodeResponse("long"), time.Hour)
+	cache.Put("forever", nodeResponse("forever"), 0)
+
+	now = now.Add(10 * time.Minute)
+	for _, tc := range []struct {
+		key   string
+		found bool
+	}{
+		{"short", false},
+		{"long", true},
+		{"forever", true},
+	} {
+		if got := cache.Get(tc.key) != nil; got != tc.found {
+			t.Errorf("Get(%s) found = %v, want %v", tc.key, got, tc.found)
+		}
+	}
+	if got := cache.Stats().Entries; got != 2 {
+		t.Errorf("Entries = %d, want 2", got)
+	}
+}
+
+func TestCacheCopies(t *testing.T) {
+	cache := NewCache(1 << 20)
+	response := nodeResponse("a")
+	cache.Put("a", response, 0)
+
+	// Neither the cached nor the returned response is shared with callers.
+	response.Data["a"].Properties = nil
+	got := cache.Get("a").(*pbv2.NodeResponse)
+	got.Data["a"].Properties = nil
+
+	if diff := cmp.Diff(cache.Get("a"), nodeResponse("a"), protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+}
+
+func TestCacheTooLarge(t *testing.T) {
+	response := nodeResponse("a")
+	cache := NewCache(entrySize("a", response) - 1)
+	cache.Put("a", response, 0)
+	if got := cache.Stats(); got.Entries != 0 || got.Bytes != 0 {
+		t.Errorf("Stats = %+v, want no entries", got)
+	}
+}
+
+func TestCachePurge(t *testing.T) {
+	cache := NewCache(1 << 20)
+	cache.Put("a", nodeResponse("a"), 0)
+	cache.Purge()
+	if got := cache.Get("a"); got != nil {
+		t.Errorf("Get(a) = %v, want nil", got)
+	}
+	if got := cache.Stats(); got.Entries != 0 || got.Bytes != 0 {
+		t.Errorf("Stats = %+v, want no entries", got)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lru
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/datacommonsorg/mixer/internal/server/cacheutil"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
+	"github.com/datacommonsorg/mixer/internal/server/redis"
+	"google.golang.org/protobuf/proto"
+)
+
+// Config is the configuration of a CacheProcessor.
+type Config struct {
+	// Maximum total size of the cached responses in bytes.
+	MaxBytes int64 `yaml:"max_bytes"`
+	// Time to live of cached responses, e.g. 1h. Responses don't expire if not set.
+	TTL time.Duration `yaml:"ttl"`
+	// Time to live of cached responses per request type, overriding TTL.
+	// Responses of types with a negative TTL are not cached.
+	TTLs map[dispatcher.RequestType]time.Duration `yaml:"ttls"`
+}
+
+// CacheProcessor implements the dispatcher.Processor interface for caching responses in memory.
+// Requests are keyed by their type and redis.GenerateCacheKey, since requests of different types
+// can have the same bytes.
+type CacheProcessor struct {
+	cache  *Cache
+	config *Config
+}
+
+func NewCacheProcessor(config *Config) *CacheProcessor {
+	return &CacheProcessor{cache: NewCache(config.MaxBytes), config: config}
+}
+
+// Stats returns the counters of the underlying cache.
+func (processor *CacheProcessor) Stats() Stats {
+	return processor.cache.Stats()
+}
+
+// PreProcess serves the current request from the cache.
+// Observation requests for multiple entities are also served from the cached responses of single entities.
+func (processor *CacheProcessor) PreProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	if processor.ttl(rc.Type) < 0 {
+		return dispatcher.Continue, nil
+	}
+	if cachedResponse := processor.get(rc.Type, rc.CurrentRequest); cachedResponse != nil {
+		rc.CurrentResponse = cachedResponse
+		return dispatcher.Done, nil
+	}
+	return cacheutil.ServeCachedEntities(rc, processor, func(request proto.Message) proto.Message {
+		return processor.get(rc.Type, request)
+	}), nil
+}
+
+func (processor *CacheProcessor) PostProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	ttl := processor.ttl(rc.Type)
+	// Don't cache partial responses.
+	cacheable := len(rc.FailedSources) == 0 && rc.CurrentResponse != nil && ttl >= 0
+	put := func(request, response proto.Message) {
+		key, err := cacheKey(rc.Type, request)
+		if err != nil {
+			// Log the error but continue processing.
+			log.Printf("Error generating cache key: %v", err)
+			return
+		}
+		processor.cache.Put(key, response, ttl)
+	}
+	if !cacheable {
+		put = nil
+	}
+
+	cacheutil.CompleteCachedEntities(rc, processor, put)
+	if put != nil {
+		put(rc.CurrentRequest, rc.CurrentResponse)
+	}
+	return dispatcher.Continue, nil
+}
+
+// cacheKey returns the key of a request of a type.
+func cacheKey(requestType dispatcher.RequestType, request proto.Message) (string, error) {
+	key, err := redis.GenerateCacheKey(request)
+	if err != nil {
+		return "", err
+	}
+	return string(requestType) + ":" + key, nil
+}
+
+// get returns the cached response of a request of a type, or nil if there is none.
+func (processor *CacheProcessor) get(requestType dispatcher.RequestType, request proto.Message) proto.Message {
+	key, err := cacheKey(requestType, request)
+	if err != nil {
+		// Log the error but continue processing.
+		log.Printf("Error generating cache key: %v", err)
+		return nil
+	}
+	return processor.cache.Get(key)
+}
+
+// Invalidate drops all cached responses.
+// Responses are only cached for the process, so they don't need to be namespaced by the data version.
+func (processor *CacheProcessor) Invalidate(ctx context.Context, version string) error {
+	processor.cache.Purge()
+	return nil
+}
+
+// ttl returns the time to live of responses of a request type.
+func (processor *CacheProcessor) ttl(requestType dispatcher.RequestType) time.Duration {
+	if ttl, ok := processor.config.TTLs[requestType]; ok {
+		return ttl
+	}
+	return processor.config.TTL
+}
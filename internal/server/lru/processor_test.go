@@ -0,0 +1,178 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lru
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestCacheProcessor(t *testing.T) {
+	request := &pbv2.NodeRequest{Nodes: []string{"a"}, Property: "->name"}
+	response := nodeResponse("a")
+	key, err := cacheKey(dispatcher.TypeNode, request)
+	if err != nil {
+		t.Fatalf("cacheKey error: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name          string
+		requestType   dispatcher.RequestType
+		failedSources []string
+		wantOutcome   dispatcher.Outcome
+		wantStats     Stats
+	}{
+		{
+			name:        "cached",
+			requestType: dispatcher.TypeNode,
+			wantOutcome: dispatcher.Done,
+			wantStats:   Stats{Hits: 1, Entries: 1, Bytes: entrySize(key, response)},
+		},
+		{
+			name:          "partial response",
+			requestType:   dispatcher.TypeNode,
+			failedSources: []string{"remote-api.datacommons.org"},
+			wantOutcome:   dispatcher.Continue,
+			wantStats:     Stats{Misses: 1},
+		},
+		{
+			name:        "disabled type",
+			requestType: dispatcher.TypeObservation,
+			wantOutcome: dispatcher.Continue,
+		},
+	} {
+		processor := NewCacheProcessor(&Config{
+			MaxBytes: 1 << 20,
+			TTL:      time.Hour,
+			TTLs:     map[dispatcher.RequestType]time.Duration{dispatcher.TypeObservation: -1},
+		})
+		rc := &dispatcher.RequestContext{
+			Context:         context.Background(),
+			Type:            tc.requestType,
+			OriginalRequest: request,
+			CurrentRequest:  request,
+			CurrentResponse: response,
+			FailedSources:   tc.failedSources,
+		}
+		if _, err := processor.PostProcess(rc); err != nil {
+			t.Fatalf("PostProcess error (%s): %v", tc.name, err)
+		}
+
+		rc = &dispatcher.RequestContext{Context: context.Background(), Type: tc.requestType, OriginalRequest: request, CurrentRequest: request}
+		outcome, err := processor.PreProcess(rc)
+		if err != nil {
+			t.Fatalf("PreProcess error (%s): %v", tc.name, err)
+		}
+		if outcome != tc.wantOutcome {
+			t.Errorf("PreProcess (%s) outcome = %v, want %v", tc.name, outcome, tc.wantOutcome)
+		}
+		if outcome == dispatcher.Done {
+			if diff := cmp.Diff(rc.CurrentResponse, response, protocmp.Transform()); diff != "" {
+				t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+			}
+		}
+
+		if diff := cmp.Diff(processor.Stats(), tc.wantStats); diff != "" {
+			t.Errorf("Unexpected stats diff (%s) %v", tc.name, diff)
+		}
+	}
+}
+
+// doneProcessor serves a fixed response, like a cache hit in a second cache tier.
+type doneProcessor struct {
+	response *pbv2.NodeResponse
+}
+
+func (p *doneProcessor) PreProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	rc.CurrentResponse = p.response
+	return dispatcher.Done, nil
+}
+
+func (p *doneProcessor) PostProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	return dispatcher.Continue, nil
+}
+
+func TestCacheProcessorTier(t *testing.T) {
+	var l1 dispatcher.Processor = NewCacheProcessor(&Config{MaxBytes: 1 << 20})
+	var l2 dispatcher.Processor = &doneProcessor{response: nodeResponse("a")}
+	d := dispatcher.NewDispatcher([]*dispatcher.Processor{&l1, &l2}, nil)
+
+	for i := 0; i < 2; i++ {
+		got, err := d.Node(context.Background(), &pbv2.NodeRequest{Nodes: []string{"a"}, Property: "->"})
+		if err != nil {
+			t.Fatalf("Node error: %v", err)
+		}
+		if diff := cmp.Diff(got, nodeResponse("a"), protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff %v", diff)
+		}
+	}
+
+	// The first response is served by the second tier and cached by the first.
+	stats := l1.(*CacheProcessor).Stats()
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Entries != 1 {
+		t.Errorf("Stats = %+v, want 1 hit, 1 miss and 1 entry", stats)
+	}
+}
+
+// typedProcessor serves a fixed response of each request type.
+type typedProcessor struct {
+	responses map[dispatcher.RequestType]proto.Message
+}
+
+func (p *typedProcessor) PreProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	rc.CurrentResponse = p.responses[rc.Type]
+	return dispatcher.Done, nil
+}
+
+func (p *typedProcessor) PostProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	return dispatcher.Continue, nil
+}
+
+func TestCacheProcessorRequestTypes(t *testing.T) {
+	resolveResponse := &pbv2.ResolveResponse{Entities: []*pbv2.ResolveResponse_Entity{{Node: "geoId/06"}}}
+	var cache dispatcher.Processor = NewCacheProcessor(&Config{MaxBytes: 1 << 20})
+	var source dispatcher.Processor = &typedProcessor{responses: map[dispatcher.RequestType]proto.Message{
+		dispatcher.TypeNode:    nodeResponse("geoId/06"),
+		dispatcher.TypeResolve: resolveResponse,
+	}}
+	d := dispatcher.NewDispatcher([]*dispatcher.Processor{&cache, &source}, nil)
+
+	// Node and Resolve requests with the same bytes aren't served each other's responses.
+	nodes, property := []string{"geoId/06"}, "<-description->dcid"
+	gotNode, err := d.Node(context.Background(), &pbv2.NodeRequest{Nodes: nodes, Property: property})
+	if err != nil {
+		t.Fatalf("Node error: %v", err)
+	}
+	if diff := cmp.Diff(gotNode, nodeResponse("geoId/06"), protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected Node diff %v", diff)
+	}
+	gotResolve, err := d.Resolve(context.Background(), &pbv2.ResolveRequest{Nodes: nodes, Property: property})
+	if err != nil {
+		t.Fatalf("Resolve error: %v", err)
+	}
+	if diff := cmp.Diff(gotResolve, resolveResponse, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected Resolve diff %v", diff)
+	}
+	if stats := cache.(*CacheProcessor).Stats(); stats.Hits != 0 || stats.Entries != 2 {
+		t.Errorf("Stats = %+v, want no hits and 2 entries", stats)
+	}
+}
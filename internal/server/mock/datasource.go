@@ -0,0 +1,196 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mock provides a data source that serves a graph held in memory.
+// It is loaded from MCF and CSV files and is meant for tests and local development.
+package mock
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datacommonsorg/mixer/internal/merger"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/datasource"
+	"github.com/datacommonsorg/mixer/internal/server/datasource/dsutil"
+	v2 "github.com/datacommonsorg/mixer/internal/server/v2"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockDataSource represents a data source that serves an in-memory graph.
+type MockDataSource struct {
+	id    string
+	graph *Graph
+}
+
+func NewMockDataSource(id string, graph *Graph) *MockDataSource {
+	return &MockDataSource{id: id, graph: graph}
+}
+
+// Type returns the type of the data source.
+func (mds *MockDataSource) Type() datasource.DataSourceType {
+	return datasource.TypeMock
+}
+
+// Id returns the id of the data source.
+func (mds *MockDataSource) Id() string {
+	return fmt.Sprintf("%s-%s", string(mds.Type()), mds.id)
+}
+
+// Node retrieves node data from the graph.
+func (mds *MockDataSource) Node(ctx context.Context, req *pbv2.NodeRequest) (*pbv2.NodeResponse, error) {
+	arcs, err := v2.ParseProperty(req.GetProperty())
+	if err != nil {
+		return nil, err
+	}
+	if len(arcs) == 0 {
+		return &pbv2.NodeResponse{}, nil
+	}
+	for _, arc := range arcs {
+		if arc.Decorator != "" && (arc.SingleProp == "" || arc.SingleProp == v2.WILDCARD || len(arc.BracketProps) > 0) {
+			return nil, status.Errorf(codes.InvalidArgument, "chain expressions are only supported for a single property")
+		}
+	}
+
+	reached, err := v2.FollowArcs(req.GetNodes(), arcs, func(nodes []string, arc *v2.Arc) (map[string][]string, error) {
+		reached := map[string][]string{}
+		for _, node := range nodes {
+			for _, e := range mds.graph.arcEdges(node, arc) {
+				if e.dcid != "" {
+					reached[node] = append(reached[node], e.dcid)
+				}
+			}
+		}
+		return reached, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lastArc := arcs[len(arcs)-1]
+	response := &pbv2.NodeResponse{Data: map[string]*pbv2.LinkedGraph{}}
+	for node, reachedNodes := range reached {
+		// Different reached nodes can lead to the same node.
+		edges := []*edge{}
+		seen := map[edge]struct{}{}
+		for _, reachedNode := range reachedNodes {
+			for _, e := range mds.graph.arcEdges(reachedNode, lastArc) {
+				if _, ok := seen[*e]; !ok {
+					seen[*e] = struct{}{}
+					edges = append(edges, e)
+				}
+			}
+		}
+		if len(edges) == 0 {
+			continue
+		}
+		if lastArc.IsNodePropertiesArc() {
+			response.Data[node] = &pbv2.LinkedGraph{Properties: edgesToProperties(edges)}
+		} else {
+			response.Data[node] = mds.graph.edgesToLinkedGraph(edges)
+		}
+	}
+	return response, nil
+}
+
+// Observation retrieves observation data from the graph.
+func (mds *MockDataSource) Observation(ctx context.Context, req *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
+	qo := dsutil.SelectFieldsToQueryOptions(req.GetSelect())
+	if !qo.Variable || !qo.Entity {
+		return nil, status.Error(codes.InvalidArgument, "Must select 'variable' and 'entity'")
+	}
+
+	valueFilter, err := util.ParseValueFilter(req.GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	variables := req.GetVariable().GetDcids()
+	entities, entityExpr := req.GetEntity().GetDcids(), req.GetEntity().GetExpression()
+	childPlaces := entities
+	if entityExpr != "" {
+		if childPlaces, err = mds.graph.childPlaces(entityExpr); err != nil {
+			return nil, err
+		}
+	}
+
+	if qo.IsExistence(variables) {
+		return dsutil.EntityVariablesToObservationResponse(variables, mds.graph.entityVariables(childPlaces)), nil
+	}
+
+	if qo.IsEmpty(variables, entities, entityExpr) {
+		return &pbv2.ObservationResponse{}, nil
+	}
+
+	date := qo.ObservationDate(entities, req.GetDate())
+	observations := mds.graph.getObservations(variables, childPlaces, date)
+	series := mds.graph.observationsToFacetSeries(observations, date, req.GetFilter())
+	return dsutil.FacetSeriesToObservationResponse(qo, variables, entities, entityExpr, series, valueFilter), nil
+}
+
+// NodeSearch searches nodes in the graph.
+func (mds *MockDataSource) NodeSearch(ctx context.Context, req *pbv2.NodeSearchRequest) (*pbv2.NodeSearchResponse, error) {
+	response := mds.graph.searchNodes(req.GetQuery(), req.GetPredicates(), req.GetTypes())
+	if len(response.Results) > merger.MAX_SEARCH_RESULTS {
+		response.Results = response.Results[:merger.MAX_SEARCH_RESULTS]
+	}
+	return response, nil
+}
+
+// Resolve resolves nodes using the triples in the graph.
+func (mds *MockDataSource) Resolve(ctx context.Context, req *pbv2.ResolveRequest) (*pbv2.ResolveResponse, error) {
+	inArc, outArc, err := v2.ParseResolveProperty(req.GetProperty())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pbv2.ResolveResponse{}
+	seenNodes := map[string]struct{}{}
+	for _, node := range req.GetNodes() {
+		if _, ok := seenNodes[node]; ok {
+			continue
+		}
+		seenNodes[node] = struct{}{}
+
+		entity := &pbv2.ResolveResponse_Entity{
+			Node:       node,
+			Candidates: []*pbv2.ResolveResponse_Entity_Candidate{},
+		}
+		for _, candidate := range mds.graph.resolveNode(node, inArc, outArc) {
+			entity.ResolvedIds = append(entity.ResolvedIds, candidate)
+			resolved := &pbv2.ResolveResponse_Entity_Candidate{Dcid: candidate}
+			if outArc.SingleProp == dcidProperty {
+				resolved.DominantType = v2.GetDominantType(mds.graph.types(candidate))
+			}
+			entity.Candidates = append(entity.Candidates, resolved)
+		}
+		response.Entities = append(response.Entities, entity)
+	}
+	return response, nil
+}
+
+// Event is not supported by the mock data source.
+// It returns an empty response so that other data sources can serve the request.
+func (mds *MockDataSource) Event(ctx context.Context, req *pbv2.EventRequest) (*pbv2.EventResponse, error) {
+	return &pbv2.EventResponse{}, nil
+}
+
+// Sparql is not supported by the mock data source.
+// It returns an empty response so that other data sources can serve the request.
+func (mds *MockDataSource) Sparql(ctx context.Context, req *pb.SparqlRequest) (*pb.QueryResponse, error) {
+	return &pb.QueryResponse{}, nil
+}
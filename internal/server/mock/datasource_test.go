@@ -0,0 +1,306 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func newTestDataSource(t *testing.T) *MockDataSource {
+	graph, err := LoadGraph([]string{
+		"../../../test/triples.csv",
+		"../../../test/observations.csv",
+		"testdata/places.mcf",
+	})
+	if err != nil {
+		t.Fatalf("LoadGraph error: %v", err)
+	}
+	return NewMockDataSource("test", graph)
+}
+
+func TestLoadGraph(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		paths []string
+	}{
+		{name: "unsupported file type", paths: []string{"testdata/places.tmcf"}},
+		{name: "missing file", paths: []string{"testdata/missing.csv"}},
+		{name: "unknown csv header", paths: []string{"../../../test/latency.csv"}},
+	} {
+		if _, err := LoadGraph(tc.paths); err == nil {
+			t.Errorf("LoadGraph (%s) = nil error, want error", tc.name)
+		}
+	}
+}
+
+func TestNode(t *testing.T) {
+	ds := newTestDataSource(t)
+	for _, tc := range []struct {
+		name string
+		req  *pbv2.NodeRequest
+		want *pbv2.NodeResponse
+	}{
+		{
+			name: "out properties",
+			req:  &pbv2.NodeRequest{Nodes: []string{"geoId/06"}, Property: "->"},
+			want: &pbv2.NodeResponse{
+				Data: map[string]*pbv2.LinkedGraph{
+					"geoId/06": {Properties: []string{"containedInPlace", "name", "typeOf", "wikidataId"}},
+				},
+			},
+		},
+		{
+			name: "out property values",
+			req:  &pbv2.NodeRequest{Nodes: []string{"test_var_1"}, Property: "->[name, memberOf]"},
+			want: &pbv2.NodeResponse{
+				Data: map[string]*pbv2.LinkedGraph{
+					"test_var_1": {
+						Arcs: map[string]*pbv2.Nodes{
+							"name": {Nodes: []*pb.EntityInfo{{Value: "total number of sql query used"}}},
+							"memberOf": {Nodes: []*pb.EntityInfo{{
+								Dcid:  "dc/g/SQLite",
+								Name:  "SQLite stat var group",
+								Types: []string{"StatVarGroup"},
+							}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "chained and filtered in arc",
+			req:  &pbv2.NodeRequest{Nodes: []string{"country/USA"}, Property: "<-containedInPlace+{typeOf:County}"},
+			want: &pbv2.NodeResponse{
+				Data: map[string]*pbv2.LinkedGraph{
+					"country/USA": {
+						Arcs: map[string]*pbv2.Nodes{
+							"containedInPlace+": {Nodes: []*pb.EntityInfo{{
+								Dcid:  "geoId/06085",
+								Name:  "Santa Clara County",
+								Types: []string{"County"},
+							}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "multiple arcs",
+			req:  &pbv2.NodeRequest{Nodes: []string{"test_var_1", "test_var_2"}, Property: "->memberOf->specializationOf"},
+			want: &pbv2.NodeResponse{
+				Data: map[string]*pbv2.LinkedGraph{
+					"test_var_1": {
+						Arcs: map[string]*pbv2.Nodes{
+							"specializationOf": {Nodes: []*pb.EntityInfo{{
+								Dcid:  "dc/g/SQL",
+								Name:  "SQL stat var group",
+								Types: []string{"StatVarGroup"},
+							}}},
+						},
+					},
+					"test_var_2": {
+						Arcs: map[string]*pbv2.Nodes{
+							"specializationOf": {Nodes: []*pb.EntityInfo{{
+								Dcid:  "dc/g/SQL",
+								Name:  "SQL stat var group",
+								Types: []string{"StatVarGroup"},
+							}}},
+						},
+					},
+				},
+			},
+		},
+	} {
+		got, err := ds.Node(context.Background(), tc.req)
+		if err != nil {
+			t.Fatalf("Node error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
+
+func TestObservation(t *testing.T) {
+	ds := newTestDataSource(t)
+	facet := &pb.Facet{ImportName: "Custom Prov", ProvenanceUrl: "custom.datacommons.org"}
+	facetId := "2921750529"
+	for _, tc := range []struct {
+		name string
+		req  *pbv2.ObservationRequest
+		want *pbv2.ObservationResponse
+	}{
+		{
+			name: "latest observations",
+			req: &pbv2.ObservationRequest{
+				Select:   []string{"variable", "entity", "date", "value"},
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"test_var_2"}},
+				Entity:   &pbv2.DcidOrExpression{Dcids: []string{"geoId/06", "geoId/07"}},
+				Date:     "LATEST",
+			},
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"test_var_2": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"geoId/06": {
+								OrderedFacets: []*pbv2.FacetObservation{{
+									FacetId:      facetId,
+									ObsCount:     1,
+									EarliestDate: "2020",
+									LatestDate:   "2020",
+									Observations: []*pb.PointStat{{Date: "2020", Value: proto.Float64(2000)}},
+								}},
+							},
+							"geoId/07": {},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{facetId: facet},
+			},
+		},
+		{
+			name: "contained in place facets",
+			req: &pbv2.ObservationRequest{
+				Select:   []string{"variable", "entity", "facet"},
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"test_var_2"}},
+				Entity:   &pbv2.DcidOrExpression{Expression: "country/USA<-containedInPlace+{typeOf:State}"},
+			},
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"test_var_2": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"": {
+								OrderedFacets: []*pbv2.FacetObservation{{
+									FacetId:      facetId,
+									ObsCount:     2,
+									EarliestDate: "2010",
+									LatestDate:   "2020",
+								}},
+							},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{facetId: facet},
+			},
+		},
+		{
+			name: "existence",
+			req: &pbv2.ObservationRequest{
+				Select:   []string{"variable", "entity"},
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"test_var_1", "test_var_3"}},
+				Entity:   &pbv2.DcidOrExpression{Dcids: []string{"geoId/05", "ein/1"}},
+			},
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"test_var_1": {
+						ByEntity: map[string]*pbv2.EntityObservation{"geoId/05": {}},
+					},
+					"test_var_3": {
+						ByEntity: map[string]*pbv2.EntityObservation{"ein/1": {}},
+					},
+				},
+			},
+		},
+	} {
+		got, err := ds.Observation(context.Background(), tc.req)
+		if err != nil {
+			t.Fatalf("Observation error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
+
+func TestNodeSearch(t *testing.T) {
+	ds := newTestDataSource(t)
+	got, err := ds.NodeSearch(context.Background(), &pbv2.NodeSearchRequest{
+		Query: "stat var",
+		Types: []string{"StatVarGroup"},
+	})
+	if err != nil {
+		t.Fatalf("NodeSearch error: %v", err)
+	}
+	want := &pbv2.NodeSearchResponse{
+		Results: []*pbv2.NodeSearchResult{
+			{
+				Node:  &pb.EntityInfo{Dcid: "dc/g/SQL", Name: "SQL stat var group", Types: []string{"StatVarGroup"}},
+				Match: &pb.PropertyValue{Property: "name", Value: "SQL stat var group"},
+			},
+			{
+				Node:  &pb.EntityInfo{Dcid: "dc/g/SQLite", Name: "SQLite stat var group", Types: []string{"StatVarGroup"}},
+				Match: &pb.PropertyValue{Property: "name", Value: "SQLite stat var group"},
+			},
+		},
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	ds := newTestDataSource(t)
+	for _, tc := range []struct {
+		name string
+		req  *pbv2.ResolveRequest
+		want *pbv2.ResolveResponse
+	}{
+		{
+			name: "description to dcid",
+			req:  &pbv2.ResolveRequest{Nodes: []string{"california", "Atlantis"}, Property: "<-description{typeOf:State}->dcid"},
+			want: &pbv2.ResolveResponse{
+				Entities: []*pbv2.ResolveResponse_Entity{
+					{
+						Node:        "california",
+						ResolvedIds: []string{"geoId/06"},
+						Candidates:  []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "geoId/06", DominantType: "State"}},
+					},
+					{
+						Node:       "Atlantis",
+						Candidates: []*pbv2.ResolveResponse_Entity_Candidate{},
+					},
+				},
+			},
+		},
+		{
+			name: "id to id",
+			req:  &pbv2.ResolveRequest{Nodes: []string{"Q1612"}, Property: "<-wikidataId->name"},
+			want: &pbv2.ResolveResponse{
+				Entities: []*pbv2.ResolveResponse_Entity{
+					{
+						Node:        "Q1612",
+						ResolvedIds: []string{"Arkansas"},
+						Candidates:  []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "Arkansas"}},
+					},
+				},
+			},
+		},
+	} {
+		got, err := ds.Resolve(context.Background(), tc.req)
+		if err != nil {
+			t.Fatalf("Resolve error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
@@ -0,0 +1,389 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Utility functions used by the MockDataSource.
+
+package mock
+
+import (
+	"sort"
+	"strings"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/datasource/dsutil"
+	v2 "github.com/datacommonsorg/mixer/internal/server/v2"
+)
+
+const (
+	namePredicate             = "name"
+	typeOfPredicate           = "typeOf"
+	urlPredicate              = "url"
+	containedInPlacePredicate = "containedInPlace"
+	dcidProperty              = "dcid"
+	descriptionProperty       = "description"
+	geoCoordinateProperty     = "geoCoordinate"
+	chainDecorator            = "+"
+)
+
+var defaultSearchPredicates = []string{namePredicate, descriptionProperty}
+
+// Variable and entity combination.
+type variableEntity struct {
+	variable string
+	entity   string
+}
+
+// edge is a node or value reached by an arc.
+type edge struct {
+	predicate string
+	dcid      string
+	value     string
+}
+
+// arcEdges returns the edges reached by an arc from a node.
+func (g *Graph) arcEdges(node string, arc *v2.Arc) []*edge {
+	if arc.Decorator == chainDecorator {
+		return g.chainedEdges(node, arc)
+	}
+	edges := []*edge{}
+	for _, t := range g.linked(node, arc.Out) {
+		if !matchesProperties(arc, t.Predicate) {
+			continue
+		}
+		e := &edge{predicate: t.Predicate, dcid: t.SubjectID}
+		if arc.Out {
+			e.dcid, e.value = t.ObjectID, t.ObjectValue
+		}
+		if !g.matchesFilter(e.dcid, arc.Filter) {
+			continue
+		}
+		edges = append(edges, e)
+	}
+	return edges
+}
+
+// chainedEdges returns the nodes reached by following the single property of an arc recursively.
+func (g *Graph) chainedEdges(node string, arc *v2.Arc) []*edge {
+	edges := []*edge{}
+	visited := map[string]struct{}{node: {}}
+	queue := []string{node}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, t := range g.linked(current, arc.Out) {
+			if t.Predicate != arc.SingleProp {
+				continue
+			}
+			next := t.SubjectID
+			if arc.Out {
+				next = t.ObjectID
+			}
+			if _, ok := visited[next]; ok || next == "" {
+				continue
+			}
+			visited[next] = struct{}{}
+			queue = append(queue, next)
+			if g.matchesFilter(next, arc.Filter) {
+				edges = append(edges, &edge{predicate: arc.SingleProp + chainDecorator, dcid: next})
+			}
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool { return edges[i].dcid < edges[j].dcid })
+	return edges
+}
+
+// matchesProperties returns whether a predicate is selected by an arc.
+func matchesProperties(arc *v2.Arc, predicate string) bool {
+	switch {
+	case arc.IsNodePropertiesArc(), arc.SingleProp == v2.WILDCARD:
+		return true
+	case arc.SingleProp != "":
+		return arc.SingleProp == predicate
+	}
+	for _, prop := range arc.BracketProps {
+		if prop == predicate {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesFilter returns whether a node has at least one of the filter values for every filter property.
+func (g *Graph) matchesFilter(node string, filter map[string][]string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	if node == "" {
+		return false
+	}
+	for prop, values := range filter {
+		if !containsAny(g.values(node, prop), values) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAny(items []string, values []string) bool {
+	for _, item := range items {
+		for _, value := range values {
+			if item == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// edgesToLinkedGraph converts edges to a LinkedGraph proto.
+// Nodes are populated with their names and types.
+func (g *Graph) edgesToLinkedGraph(edges []*edge) *pbv2.LinkedGraph {
+	linkedGraph := &pbv2.LinkedGraph{Arcs: map[string]*pbv2.Nodes{}}
+	for _, e := range edges {
+		nodes, ok := linkedGraph.Arcs[e.predicate]
+		if !ok {
+			nodes = &pbv2.Nodes{}
+			linkedGraph.Arcs[e.predicate] = nodes
+		}
+		node := &pb.EntityInfo{Dcid: e.dcid, Value: e.value}
+		if e.dcid != "" {
+			node.Name = g.name(e.dcid)
+			node.Types = g.types(e.dcid)
+		}
+		nodes.Nodes = append(nodes.Nodes, node)
+	}
+	return linkedGraph
+}
+
+// edgesToProperties returns the sorted unique predicates of edges.
+func edgesToProperties(edges []*edge) []string {
+	propSet := map[string]struct{}{}
+	for _, e := range edges {
+		propSet[e.predicate] = struct{}{}
+	}
+	properties := []string{}
+	for prop := range propSet {
+		properties = append(properties, prop)
+	}
+	sort.Strings(properties)
+	return properties
+}
+
+// childPlaces returns the child places specified by a contained-in entity expression.
+func (g *Graph) childPlaces(entityExpr string) ([]string, error) {
+	containedInPlace, err := v2.ParseContainedInPlace(entityExpr)
+	if err != nil {
+		return nil, err
+	}
+	ancestor, childPlaceType := containedInPlace.Ancestor, containedInPlace.ChildPlaceType
+	if ancestor == childPlaceType {
+		return g.nodesOfType(childPlaceType), nil
+	}
+	arc := &v2.Arc{
+		SingleProp: containedInPlacePredicate,
+		Decorator:  chainDecorator,
+		Filter:     map[string][]string{typeOfPredicate: {childPlaceType}},
+	}
+	childPlaces := []string{}
+	for _, e := range g.chainedEdges(ancestor, arc) {
+		childPlaces = append(childPlaces, e.dcid)
+	}
+	return childPlaces, nil
+}
+
+// getObservations returns the observations of the variables and entities.
+// If the date is empty or LATEST, observations of all dates are returned.
+func (g *Graph) getObservations(variables, entities []string, date string) []*Observation {
+	variableSet, entitySet := toSet(variables), toSet(entities)
+	observations := []*Observation{}
+	for _, observation := range g.observations {
+		if _, ok := variableSet[observation.Variable]; !ok {
+			continue
+		}
+		if _, ok := entitySet[observation.Entity]; !ok {
+			continue
+		}
+		if date != "" && date != dsutil.LatestDate && observation.Date != date {
+			continue
+		}
+		observations = append(observations, observation)
+	}
+	return observations
+}
+
+// entityVariables returns the variables with observations of each entity.
+func (g *Graph) entityVariables(entities []string) map[string][]string {
+	entitySet := toSet(entities)
+	result := map[string][]string{}
+	seen := map[variableEntity]struct{}{}
+	for _, observation := range g.observations {
+		if _, ok := entitySet[observation.Entity]; !ok {
+			continue
+		}
+		key := variableEntity{variable: observation.Variable, entity: observation.Entity}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result[observation.Entity] = append(result[observation.Entity], observation.Variable)
+	}
+	return result
+}
+
+func toSet(items []string) map[string]struct{} {
+	set := map[string]struct{}{}
+	for _, item := range items {
+		set[item] = struct{}{}
+	}
+	return set
+}
+
+// provenanceFacet returns the facet of a provenance, with the name and url of the provenance node.
+// The import name is the provenance dcid if the node has no name.
+func (g *Graph) provenanceFacet(provenance string) *pb.Facet {
+	facet := &pb.Facet{ImportName: provenance}
+	if name := g.name(provenance); name != "" {
+		facet.ImportName = name
+	}
+	if urls := g.values(provenance, urlPredicate); len(urls) > 0 {
+		facet.ProvenanceUrl = urls[0]
+	}
+	return facet
+}
+
+// observationsToFacetSeries groups observations by variable, entity and facet.
+// The observations are expected to be sorted by date (ascending).
+func (g *Graph) observationsToFacetSeries(observations []*Observation, date string, filter *pbv2.FacetFilter) []*dsutil.FacetSeries {
+	builder := dsutil.NewFacetSeriesBuilder(filter)
+	for _, observation := range observations {
+		facet := dsutil.ObservationFacet(
+			g.provenanceFacet(observation.Provenance),
+			observation.Unit,
+			observation.ScalingFactor,
+			observation.MeasurementMethod,
+			observation.ObservationPeriod,
+		)
+		builder.Add(observation.Variable, observation.Entity, observation.Date, observation.Value, facet)
+	}
+	return builder.Series(date)
+}
+
+// searchNodes returns the nodes with a value of one of the predicates that contains all tokens of the query,
+// case insensitively. Values that start with the query are ranked first.
+func (g *Graph) searchNodes(query string, predicates []string, types []string) *pbv2.NodeSearchResponse {
+	response := &pbv2.NodeSearchResponse{}
+	tokens := strings.Fields(strings.ToLower(query))
+	if len(tokens) == 0 {
+		return response
+	}
+	if len(predicates) == 0 {
+		predicates = defaultSearchPredicates
+	}
+	predicateSet := toSet(predicates)
+
+	matches := []*Triple{}
+	for _, t := range g.triples {
+		if _, ok := predicateSet[t.Predicate]; !ok || t.ObjectValue == "" {
+			continue
+		}
+		if len(types) > 0 && !containsAny(g.types(t.SubjectID), types) {
+			continue
+		}
+		value := strings.ToLower(t.ObjectValue)
+		matchesAll := true
+		for _, token := range tokens {
+			if !strings.Contains(value, token) {
+				matchesAll = false
+				break
+			}
+		}
+		if matchesAll {
+			matches = append(matches, t)
+		}
+	}
+
+	prefix := strings.ToLower(query)
+	sort.SliceStable(matches, func(i, j int) bool {
+		iPrefix := strings.HasPrefix(strings.ToLower(matches[i].ObjectValue), prefix)
+		jPrefix := strings.HasPrefix(strings.ToLower(matches[j].ObjectValue), prefix)
+		if iPrefix != jPrefix {
+			return iPrefix
+		}
+		if len(matches[i].ObjectValue) != len(matches[j].ObjectValue) {
+			return len(matches[i].ObjectValue) < len(matches[j].ObjectValue)
+		}
+		return matches[i].SubjectID < matches[j].SubjectID
+	})
+
+	for _, t := range matches {
+		response.Results = append(response.Results, &pbv2.NodeSearchResult{
+			Node: &pb.EntityInfo{
+				Dcid:  t.SubjectID,
+				Name:  g.name(t.SubjectID),
+				Types: g.types(t.SubjectID),
+			},
+			Match: &pb.PropertyValue{
+				Property: t.Predicate,
+				Value:    t.ObjectValue,
+			},
+		})
+	}
+	return response
+}
+
+// resolveNode returns the candidates of a node for a resolve property.
+func (g *Graph) resolveNode(node string, inArc, outArc *v2.Arc) []string {
+	var subjects []string
+	switch {
+	case inArc.SingleProp == geoCoordinateProperty:
+		// Coordinates cannot be resolved using triples.
+		return nil
+	case inArc.SingleProp == descriptionProperty:
+		for _, t := range g.triples {
+			if t.Predicate == namePredicate && strings.EqualFold(t.ObjectValue, node) {
+				subjects = append(subjects, t.SubjectID)
+			}
+		}
+	case inArc.SingleProp == dcidProperty:
+		if len(g.out[node]) > 0 {
+			subjects = []string{node}
+		}
+	default:
+		for _, t := range g.triples {
+			if t.Predicate == inArc.SingleProp && (t.ObjectValue == node || t.ObjectID == node) {
+				subjects = append(subjects, t.SubjectID)
+			}
+		}
+	}
+
+	candidates := []string{}
+	seen := map[string]struct{}{}
+	for _, subject := range subjects {
+		if types := inArc.Filter[typeOfPredicate]; len(types) > 0 && !containsAny(g.types(subject), types) {
+			continue
+		}
+		values := []string{subject}
+		if outArc.SingleProp != dcidProperty {
+			values = g.values(subject, outArc.SingleProp)
+		}
+		for _, value := range values {
+			if _, ok := seen[value]; !ok {
+				seen[value] = struct{}{}
+				candidates = append(candidates, value)
+			}
+		}
+	}
+	return candidates
+}
@@ -0,0 +1,259 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/datacommonsorg/mixer/internal/parser/mcf"
+)
+
+// Columns of the CSV files, matching the triples and observations tables of the SQL database.
+var (
+	triplesColumns      = []string{"subject_id", "predicate", "object_id", "object_value"}
+	observationsColumns = []string{"entity", "variable", "date", "value", "provenance"}
+	// Optional facet columns of observations.
+	facetColumns = []string{"unit", "scaling_factor", "measurement_method", "observation_period"}
+)
+
+// Triple is a triple of the graph.
+// The object is either a node (ObjectID) or a value (ObjectValue).
+type Triple struct {
+	SubjectID   string
+	Predicate   string
+	ObjectID    string
+	ObjectValue string
+}
+
+// Observation is a single observation of a variable for an entity.
+type Observation struct {
+	Entity            string
+	Variable          string
+	Date              string
+	Value             float64
+	Provenance        string
+	Unit              string
+	ScalingFactor     string
+	MeasurementMethod string
+	ObservationPeriod string
+}
+
+// Graph holds triples and observations in memory.
+type Graph struct {
+	// Triples keyed by subject id.
+	out map[string][]*Triple
+	// Triples with an object id keyed by object id.
+	in map[string][]*Triple
+	// All triples in load order.
+	triples []*Triple
+	// Observations sorted by variable, entity and date.
+	observations []*Observation
+}
+
+// LoadGraph loads a graph from MCF and CSV files.
+// CSV files are loaded as triples or observations depending on their header,
+// which uses the column names of the triples and observations tables of the SQL database.
+func LoadGraph(paths []string) (*Graph, error) {
+	g := &Graph{out: map[string][]*Triple{}, in: map[string][]*Triple{}}
+	for _, path := range paths {
+		var err error
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".mcf":
+			err = g.loadMCF(path)
+		case ".csv":
+			err = g.loadCSV(path)
+		default:
+			err = fmt.Errorf("unsupported file type")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error loading %s: %v", path, err)
+		}
+	}
+	sort.SliceStable(g.observations, func(i, j int) bool {
+		a, b := g.observations[i], g.observations[j]
+		if a.Variable != b.Variable {
+			return a.Variable < b.Variable
+		}
+		if a.Entity != b.Entity {
+			return a.Entity < b.Entity
+		}
+		return a.Date < b.Date
+	})
+	return g, nil
+}
+
+func (g *Graph) addTriple(t *Triple) {
+	g.triples = append(g.triples, t)
+	g.out[t.SubjectID] = append(g.out[t.SubjectID], t)
+	if t.ObjectID != "" {
+		g.in[t.ObjectID] = append(g.in[t.ObjectID], t)
+	}
+}
+
+func (g *Graph) loadMCF(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	triples, err := mcf.ParseTriples(string(data))
+	if err != nil {
+		return err
+	}
+	for _, t := range triples {
+		g.addTriple(&Triple{
+			SubjectID:   t.SubjectID,
+			Predicate:   t.Predicate,
+			ObjectID:    t.ObjectID,
+			ObjectValue: t.ObjectValue,
+		})
+	}
+	return nil
+}
+
+func (g *Graph) loadCSV(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("error reading header: %v", err)
+	}
+	columns := map[string]int{}
+	for i, column := range header {
+		columns[strings.TrimSpace(column)] = i
+	}
+	hasColumns := func(names []string) bool {
+		for _, name := range names {
+			if _, ok := columns[name]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+	get := func(row []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return row[i]
+		}
+		return ""
+	}
+
+	var addRow func(row []string) error
+	switch {
+	case hasColumns(triplesColumns):
+		addRow = func(row []string) error {
+			g.addTriple(&Triple{
+				SubjectID:   get(row, "subject_id"),
+				Predicate:   get(row, "predicate"),
+				ObjectID:    get(row, "object_id"),
+				ObjectValue: get(row, "object_value"),
+			})
+			return nil
+		}
+	case hasColumns(observationsColumns):
+		addRow = func(row []string) error {
+			value, err := strconv.ParseFloat(get(row, "value"), 64)
+			if err != nil {
+				return fmt.Errorf("invalid observation value: %v", row)
+			}
+			g.observations = append(g.observations, &Observation{
+				Entity:            get(row, "entity"),
+				Variable:          get(row, "variable"),
+				Date:              get(row, "date"),
+				Value:             value,
+				Provenance:        get(row, "provenance"),
+				Unit:              get(row, facetColumns[0]),
+				ScalingFactor:     get(row, facetColumns[1]),
+				MeasurementMethod: get(row, facetColumns[2]),
+				ObservationPeriod: get(row, facetColumns[3]),
+			})
+			return nil
+		}
+	default:
+		return fmt.Errorf("CSV header must have columns %v or %v", triplesColumns, observationsColumns)
+	}
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := addRow(row); err != nil {
+			return err
+		}
+	}
+}
+
+// values returns the object ids or values of a predicate of a node.
+func (g *Graph) values(node, predicate string) []string {
+	values := []string{}
+	for _, t := range g.out[node] {
+		if t.Predicate != predicate {
+			continue
+		}
+		if t.ObjectID != "" {
+			values = append(values, t.ObjectID)
+		} else {
+			values = append(values, t.ObjectValue)
+		}
+	}
+	return values
+}
+
+// name returns the name of a node.
+func (g *Graph) name(node string) string {
+	if names := g.values(node, namePredicate); len(names) > 0 {
+		return names[0]
+	}
+	return ""
+}
+
+// types returns the types of a node.
+func (g *Graph) types(node string) []string {
+	return g.values(node, typeOfPredicate)
+}
+
+// linked returns the triples of a node in the direction of an arc.
+func (g *Graph) linked(node string, out bool) []*Triple {
+	if out {
+		return g.out[node]
+	}
+	return g.in[node]
+}
+
+// nodesOfType returns the nodes of a type, sorted.
+func (g *Graph) nodesOfType(typ string) []string {
+	nodes := []string{}
+	for _, t := range g.in[typ] {
+		if t.Predicate == typeOfPredicate {
+			nodes = append(nodes, t.SubjectID)
+		}
+	}
+	sort.Strings(nodes)
+	return nodes
+}
@@ -20,8 +20,12 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/datacommonsorg/mixer/internal/server/cacheutil"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
 	"github.com/datacommonsorg/mixer/internal/util"
 	"github.com/go-redis/redis/v8"
 	"google.golang.org/protobuf/proto"
@@ -35,17 +39,36 @@ const (
 	cacheKeyPrefix = "mixer:"
 	// protoRequestKeyPrefix is the prefix for all protobuf request cache keys.
 	protoRequestKeyPrefix = cacheKeyPrefix + "request:"
+	// versionKeyPrefix is the prefix for all cache keys of a data version.
+	versionKeyPrefix = cacheKeyPrefix + "v:"
+	// purgeBatchSize is the number of keys scanned and deleted at a time when purging a version.
+	purgeBatchSize = 1000
 )
 
 // CacheClient handles Redis caching for protobuf messages.
 type CacheClient struct {
 	redisClient *redis.Client
 	expiration  time.Duration
+	// Expiration per request type, overriding expiration.
+	expirations map[dispatcher.RequestType]time.Duration
+
+	mu sync.RWMutex
+	// Data version that keys are namespaced by, unversioned if empty.
+	version string
 }
 
 // NewCacheClient creates a new CacheClient from a yaml config string.
 func NewCacheClient(redisConfigYaml string) (*CacheClient, error) {
-	redisAddress, err := GetRedisAddress(redisConfigYaml)
+	config, err := ParseRedisConfig(redisConfigYaml)
+	if err != nil {
+		return nil, err
+	}
+	return NewCacheClientFromConfig(config)
+}
+
+// NewCacheClientFromConfig creates a new CacheClient from a config.
+func NewCacheClientFromConfig(config *RedisConfig) (*CacheClient, error) {
+	redisAddress, err := config.Address()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get Redis address: %w", err)
 	}
@@ -61,7 +84,12 @@ func NewCacheClient(redisConfigYaml string) (*CacheClient, error) {
 
 	log.Printf("Connected to Redis at: %s", redisAddress)
 
-	return newCacheClient(redisClient, defaultExpiration), nil
+	client := newCacheClient(redisClient, defaultExpiration)
+	if config.TTL > 0 {
+		client.expiration = config.TTL
+	}
+	client.expirations = config.TTLs
+	return client, nil
 }
 func newCacheClient(redisClient *redis.Client, expiration time.Duration) *CacheClient {
 	return &CacheClient{
@@ -70,14 +98,41 @@ func newCacheClient(redisClient *redis.Client, expiration time.Duration) *CacheC
 	}
 }
 
+// Expiration returns the expiration of cached responses of a request type.
+// Responses with a negative expiration are not cached.
+func (c *CacheClient) Expiration(requestType dispatcher.RequestType) time.Duration {
+	if expiration, ok := c.expirations[requestType]; ok {
+		return expiration
+	}
+	return c.expiration
+}
+
+// Version returns the data version that keys are namespaced by.
+func (c *CacheClient) Version() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.version
+}
+
+// SetVersion namespaces keys by a new data version and returns the previous one.
+func (c *CacheClient) SetVersion(version string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	previous := c.version
+	c.version = version
+	return previous
+}
+
 // Close closes the underlying redis connection.
 func (c *CacheClient) Close() error {
 	return c.redisClient.Close()
 }
 
-// generateCacheKey generates a unique cache key from a protobuf request.
-func generateCacheKey(request proto.Message) (string, error) {
-	marshaled, err := proto.Marshal(request)
+// GenerateCacheKey generates a unique cache key from a protobuf request.
+// It is shared by all response caches so that they key requests the same way.
+// Requests are canonicalized first, so that requests with the same response share a key.
+func GenerateCacheKey(request proto.Message) (string, error) {
+	marshaled, err := proto.MarshalOptions{Deterministic: true}.Marshal(cacheutil.Canonicalize(request))
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal request: %w", err)
 	}
@@ -86,9 +141,47 @@ func generateCacheKey(request proto.Message) (string, error) {
 	return protoRequestKeyPrefix + hex.EncodeToString(hash[:]), nil
 }
 
+// versionedKey namespaces a cache key by a data version.
+func versionedKey(version, key string) string {
+	if version == "" {
+		return key
+	}
+	return versionKeyPrefix + version + ":" + strings.TrimPrefix(key, cacheKeyPrefix)
+}
+
+// versionPattern returns the pattern that matches all cache keys of a data version.
+func versionPattern(version string) string {
+	return versionedKey(version, protoRequestKeyPrefix) + "*"
+}
+
+// typedKey namespaces a cache key by a request type, since requests of different types can have the same bytes.
+// Keys of requests without a type are unchanged.
+func typedKey(requestType dispatcher.RequestType, key string) string {
+	if requestType == "" {
+		return key
+	}
+	return protoRequestKeyPrefix + string(requestType) + ":" + strings.TrimPrefix(key, protoRequestKeyPrefix)
+}
+
+// cacheKey generates the cache key of a request of a type in the current data version.
+// Keys are matched by versionPattern, so that keys from before request types were in keys
+// are purged with their version.
+func (c *CacheClient) cacheKey(requestType dispatcher.RequestType, request proto.Message) (string, error) {
+	key, err := GenerateCacheKey(request)
+	if err != nil {
+		return "", err
+	}
+	return versionedKey(c.Version(), typedKey(requestType, key)), nil
+}
+
 // GetCachedResponse retrieves a cached protobuf response from Redis.
 func (c *CacheClient) GetCachedResponse(ctx context.Context, request proto.Message, response proto.Message) (bool, error) {
-	key, err := generateCacheKey(request)
+	return c.GetTypedCachedResponse(ctx, "", request, response)
+}
+
+// GetTypedCachedResponse retrieves a cached protobuf response of a request type from Redis.
+func (c *CacheClient) GetTypedCachedResponse(ctx context.Context, requestType dispatcher.RequestType, request proto.Message, response proto.Message) (bool, error) {
+	key, err := c.cacheKey(requestType, request)
 	if err != nil {
 		return false, err
 	}
@@ -120,7 +213,22 @@ func (c *CacheClient) GetCachedResponse(ctx context.Context, request proto.Messa
 
 // CacheResponse stores a protobuf response in Redis.
 func (c *CacheClient) CacheResponse(ctx context.Context, request proto.Message, response proto.Message) error {
-	key, err := generateCacheKey(request)
+	return c.cacheResponse(ctx, "", request, response, c.expiration)
+}
+
+// CacheTypedResponse stores a protobuf response in Redis with the expiration of its request type.
+func (c *CacheClient) CacheTypedResponse(ctx context.Context, requestType dispatcher.RequestType, request proto.Message, response proto.Message) error {
+	return c.cacheResponse(ctx, requestType, request, response, c.Expiration(requestType))
+}
+
+func (c *CacheClient) cacheResponse(
+	ctx context.Context,
+	requestType dispatcher.RequestType,
+	request proto.Message,
+	response proto.Message,
+	expiration time.Duration,
+) error {
+	key, err := c.cacheKey(requestType, request)
 	if err != nil {
 		return err
 	}
@@ -140,10 +248,32 @@ func (c *CacheClient) CacheResponse(ctx context.Context, request proto.Message,
 		return fmt.Errorf("failed to zip: %w", err)
 	}
 
-	err = c.redisClient.Set(ctx, key, cached, c.expiration).Err()
+	err = c.redisClient.Set(ctx, key, cached, expiration).Err()
 	if err != nil {
 		return fmt.Errorf("failed to set in Redis: %w", err)
 	}
 
 	return nil
 }
+
+// Purge deletes all cached responses of a data version and returns the number of deleted keys.
+func (c *CacheClient) Purge(ctx context.Context, version string) (int, error) {
+	deleted := 0
+	var cursor uint64
+	for {
+		keys, next, err := c.redisClient.Scan(ctx, cursor, versionPattern(version), purgeBatchSize).Result()
+		if err != nil {
+			return deleted, fmt.Errorf("failed to scan Redis: %w", err)
+		}
+		if len(keys) > 0 {
+			if err := c.redisClient.Del(ctx, keys...).Err(); err != nil {
+				return deleted, fmt.Errorf("failed to delete from Redis: %w", err)
+			}
+			deleted += len(keys)
+		}
+		if next == 0 {
+			return deleted, nil
+		}
+		cursor = next
+	}
+}
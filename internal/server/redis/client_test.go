@@ -16,11 +16,13 @@ package redis
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	v2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
 	"github.com/datacommonsorg/mixer/internal/util"
 	"github.com/go-redis/redismock/v8"
 	"github.com/stretchr/testify/assert"
@@ -41,7 +43,7 @@ func TestCacheClient(t *testing.T) {
 	expectedResponse := &v2.NodeResponse{Data: map[string]*v2.LinkedGraph{"testNode": {}}}
 
 	// Mock CacheResponse.
-	key, _ := generateCacheKey(request)
+	key, _ := GenerateCacheKey(request)
 	anyMsg, _ := anypb.New(expectedResponse)
 	marshaled, _ := proto.Marshal(anyMsg)
 	cached, _ := util.Zip(marshaled)
@@ -60,7 +62,7 @@ func TestCacheClient(t *testing.T) {
 
 	// Test cache miss.
 	request2 := &v2.NodeRequest{Nodes: []string{"cacheMissNode"}}
-	key2, _ := generateCacheKey(request2)
+	key2, _ := GenerateCacheKey(request2)
 	mock.ExpectGet(key2).RedisNil()
 
 	response2 := &v2.NodeResponse{}
@@ -148,3 +150,51 @@ func repeat(s string, n int) []string {
 	}
 	return out
 }
+
+func TestCacheClientVersion(t *testing.T) {
+	ctx := context.Background()
+	rdb, mock := redismock.NewClientMock()
+	client := newCacheClient(rdb, time.Hour)
+	client.expirations = map[dispatcher.RequestType]time.Duration{dispatcher.TypeObservation: time.Minute}
+
+	request := &v2.NodeRequest{Nodes: []string{"testNode"}}
+	response := &v2.NodeResponse{Data: map[string]*v2.LinkedGraph{"testNode": {}}}
+	unversionedKey, _ := GenerateCacheKey(request)
+	key := "mixer:v:abc:request:Observation:" + strings.TrimPrefix(unversionedKey, protoRequestKeyPrefix)
+
+	assert.Equal(t, "", client.SetVersion("abc"))
+	assert.Equal(t, "abc", client.Version())
+
+	anyMsg, _ := anypb.New(response)
+	marshaled, _ := proto.Marshal(anyMsg)
+	cached, _ := util.Zip(marshaled)
+	mock.ExpectSet(key, cached, time.Minute).SetVal("OK")
+	assert.NoError(t, client.CacheTypedResponse(ctx, dispatcher.TypeObservation, request, response))
+
+	mock.ExpectScan(0, "mixer:v:abc:request:*", purgeBatchSize).SetVal([]string{key}, 7)
+	mock.ExpectDel(key).SetVal(1)
+	mock.ExpectScan(7, "mixer:v:abc:request:*", purgeBatchSize).SetVal([]string{}, 0)
+	deleted, err := client.Purge(ctx, "abc")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	mock.ExpectScan(0, "mixer:request:*", purgeBatchSize).SetVal([]string{unversionedKey}, 0)
+	mock.ExpectDel(unversionedKey).SetVal(1)
+	deleted, err = client.Purge(ctx, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCacheKeyRequestTypes(t *testing.T) {
+	client := newCacheClient(nil, time.Hour)
+	// Node and Resolve requests with the same bytes don't share a key.
+	nodeKey, err := client.cacheKey(dispatcher.TypeNode, &v2.NodeRequest{Nodes: []string{"geoId/06"}, Property: "<-description->dcid"})
+	assert.NoError(t, err)
+	resolveKey, err := client.cacheKey(dispatcher.TypeResolve, &v2.ResolveRequest{Nodes: []string{"geoId/06"}, Property: "<-description->dcid"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, nodeKey, resolveKey)
+	assert.True(t, strings.HasPrefix(nodeKey, "mixer:request:Node:"))
+	assert.True(t, strings.HasPrefix(resolveKey, "mixer:request:Resolve:"))
+}
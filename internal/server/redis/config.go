@@ -20,7 +20,9 @@ import (
 	"log"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
 	"gopkg.in/yaml.v3"
 )
 
@@ -35,6 +37,11 @@ type RedisConfig struct {
 	// and the order of the map is not guaranteed.
 	// So using a slice of RedisInstanceConfig instead.
 	Instances []RedisInstanceConfig `yaml:"instances"`
+	// Expiration of cached responses, e.g. 12h. Defaults to 24h.
+	TTL time.Duration `yaml:"ttl"`
+	// Expiration of cached responses per request type, e.g. Observation: 1h, overriding TTL.
+	// Responses of types with a negative expiration are not cached.
+	TTLs map[dispatcher.RequestType]time.Duration `yaml:"ttls"`
 }
 
 // RedisInstanceConfig represents the configuration for a specific Redis instance.
@@ -99,14 +106,27 @@ func getRegionWithLogging() string {
 	return region
 }
 
-// getRedisAddress retrieves the Redis address string ("host:port") from a YAML string for the specified region.
-func getRedisAddress(yamlStr string, region string) (string, error) {
+// ParseRedisConfig parses the Redis configuration from a YAML string.
+func ParseRedisConfig(yamlStr string) (*RedisConfig, error) {
 	var config RedisConfig
 	err := yaml.Unmarshal([]byte(yamlStr), &config)
 	if err != nil {
-		return "", fmt.Errorf("failed to decode redis config: %w", err)
+		return nil, fmt.Errorf("failed to decode redis config: %w", err)
 	}
+	return &config, nil
+}
 
+// getRedisAddress retrieves the Redis address string ("host:port") from a YAML string for the specified region.
+func getRedisAddress(yamlStr string, region string) (string, error) {
+	config, err := ParseRedisConfig(yamlStr)
+	if err != nil {
+		return "", err
+	}
+	return config.address(region)
+}
+
+// address returns the Redis address string ("host:port") for the specified region.
+func (config *RedisConfig) address(region string) (string, error) {
 	// Find a matching region
 	for _, regionConfig := range config.Instances {
 		if regionConfig.Region == region {
@@ -124,6 +144,14 @@ func getRedisAddress(yamlStr string, region string) (string, error) {
 
 // GetRedisAddress retrieves the Redis address string ("host:port") from a YAML string for the region retrieved from the Google metadata server.
 func GetRedisAddress(yamlConfig string) (string, error) {
-	region := getRegionWithLogging()
-	return getRedisAddress(yamlConfig, region)
+	config, err := ParseRedisConfig(yamlConfig)
+	if err != nil {
+		return "", err
+	}
+	return config.Address()
+}
+
+// Address retrieves the Redis address string ("host:port") for the region retrieved from the Google metadata server.
+func (config *RedisConfig) Address() (string, error) {
+	return config.address(getRegionWithLogging())
 }
@@ -16,7 +16,9 @@ package redis
 
 import (
 	"testing"
+	"time"
 
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -65,3 +67,22 @@ instances:
 		})
 	}
 }
+
+func TestParseRedisConfigTTLs(t *testing.T) {
+	config, err := ParseRedisConfig(`
+instances:
+  - region: us-central1
+    host: redis.example.com
+    port: "6379"
+ttl: 12h
+ttls:
+  Observation: 1h
+  NodeSearch: -1s
+`)
+	assert.NoError(t, err)
+	assert.Equal(t, 12*time.Hour, config.TTL)
+	assert.Equal(t, map[dispatcher.RequestType]time.Duration{
+		dispatcher.TypeObservation: time.Hour,
+		dispatcher.TypeNodeSearch:  -time.Second,
+	}, config.TTLs)
+}
@@ -15,9 +15,13 @@
 package redis
 
 import (
+	"context"
+	"fmt"
 	"log"
 
+	pb "github.com/datacommonsorg/mixer/internal/proto"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/cacheutil"
 	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
 	"google.golang.org/protobuf/proto"
 )
@@ -31,30 +35,70 @@ func NewCacheProcessor(client *CacheClient) *CacheProcessor {
 	return &CacheProcessor{client: client}
 }
 
+// PreProcess serves the current request from the cache.
+// Observation requests for multiple entities are also served from the cached responses of single entities.
 func (processor *CacheProcessor) PreProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
-	cachedResponse := newEmptyResponse(rc.Type)
-	if found, err := processor.client.GetCachedResponse(rc.Context, rc.OriginalRequest, cachedResponse); found {
-		log.Printf("Cache hit: %T", rc.OriginalRequest)
+	if processor.client.Expiration(rc.Type) < 0 {
+		return dispatcher.Continue, nil
+	}
+	if cachedResponse := processor.get(rc, rc.CurrentRequest); cachedResponse != nil {
+		log.Printf("Cache hit: %T", rc.CurrentRequest)
 
 		rc.CurrentResponse = cachedResponse
-		return dispatcher.Done, err
-	} else if err != nil {
-		// Log the error but continue processing.
-		log.Printf("Error getting cached response: %v", err)
+		return dispatcher.Done, nil
 	}
-	return dispatcher.Continue, nil
+	return cacheutil.ServeCachedEntities(rc, processor, func(request proto.Message) proto.Message {
+		return processor.get(rc, request)
+	}), nil
 }
 
 func (processor *CacheProcessor) PostProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
-	if rc.CurrentResponse != nil {
-		if err := processor.client.CacheResponse(rc.Context, rc.OriginalRequest, rc.CurrentResponse); err != nil {
+	// Don't cache partial responses.
+	cacheable := len(rc.FailedSources) == 0 && rc.CurrentResponse != nil && processor.client.Expiration(rc.Type) >= 0
+	put := func(request, response proto.Message) {
+		if err := processor.client.CacheTypedResponse(rc.Context, rc.Type, request, response); err != nil {
 			// Log the error but continue processing.
 			log.Printf("Error caching response: %v", err)
 		}
 	}
+	if !cacheable {
+		put = nil
+	}
+
+	cacheutil.CompleteCachedEntities(rc, processor, put)
+	if put != nil {
+		put(rc.CurrentRequest, rc.CurrentResponse)
+	}
 	return dispatcher.Continue, nil
 }
 
+// get returns the cached response of a request, or nil if there is none.
+func (processor *CacheProcessor) get(rc *dispatcher.RequestContext, request proto.Message) proto.Message {
+	cachedResponse := newEmptyResponse(rc.Type)
+	found, err := processor.client.GetTypedCachedResponse(rc.Context, rc.Type, request, cachedResponse)
+	if err != nil {
+		// Log the error but continue processing.
+		log.Printf("Error getting cached response: %v", err)
+		return nil
+	}
+	if !found {
+		return nil
+	}
+	return cachedResponse
+}
+
+// Invalidate namespaces new cached responses by a data version and purges the responses of the previous version.
+// Responses of the current version are purged if the version doesn't change.
+func (processor *CacheProcessor) Invalidate(ctx context.Context, version string) error {
+	previous := processor.client.SetVersion(version)
+	deleted, err := processor.client.Purge(ctx, previous)
+	if err != nil {
+		return fmt.Errorf("error purging Redis cache version %q: %w", previous, err)
+	}
+	log.Printf("Redis cache version updated from %q to %q, purged %d responses", previous, version, deleted)
+	return nil
+}
+
 // newEmptyResponse returns a new empty response for the given request type.
 func newEmptyResponse(requestType dispatcher.RequestType) proto.Message {
 	switch requestType {
@@ -66,6 +110,10 @@ func newEmptyResponse(requestType dispatcher.RequestType) proto.Message {
 		return &pbv2.ObservationResponse{}
 	case dispatcher.TypeResolve:
 		return &pbv2.ResolveResponse{}
+	case dispatcher.TypeEvent:
+		return &pbv2.EventResponse{}
+	case dispatcher.TypeSparql:
+		return &pb.QueryResponse{}
 	default:
 		return nil
 	}
@@ -48,7 +48,8 @@ func TestCacheProcessorPreProcess(t *testing.T) {
 			mockSetup: func(mock redismock.ClientMock) {
 				request := &pbv2.NodeRequest{Nodes: []string{"testNode"}}
 				response := &pbv2.NodeResponse{Data: map[string]*pbv2.LinkedGraph{"testNode": {}}}
-				key, _ := generateCacheKey(request)
+				key, _ := GenerateCacheKey(request)
+				key = typedKey(dispatcher.TypeNode, key)
 				anyMsg, _ := anypb.New(response)
 				marshaled, _ := proto.Marshal(anyMsg)
 				cached, _ := util.Zip(marshaled)
@@ -64,7 +65,8 @@ func TestCacheProcessorPreProcess(t *testing.T) {
 			requestType: dispatcher.TypeNode,
 			mockSetup: func(mock redismock.ClientMock) {
 				request := &pbv2.NodeRequest{Nodes: []string{"testNode"}}
-				key, _ := generateCacheKey(request)
+				key, _ := GenerateCacheKey(request)
+				key = typedKey(dispatcher.TypeNode, key)
 				mock.ExpectGet(key).RedisNil()
 			},
 			originalRequest: &pbv2.NodeRequest{Nodes: []string{"testNode"}},
@@ -77,7 +79,8 @@ func TestCacheProcessorPreProcess(t *testing.T) {
 			requestType: dispatcher.TypeNode,
 			mockSetup: func(mock redismock.ClientMock) {
 				request := &pbv2.NodeRequest{Nodes: []string{"testNode"}}
-				key, _ := generateCacheKey(request)
+				key, _ := GenerateCacheKey(request)
+				key = typedKey(dispatcher.TypeNode, key)
 				mock.ExpectGet(key).SetErr(errors.New("redis error"))
 			},
 			originalRequest: &pbv2.NodeRequest{Nodes: []string{"testNode"}},
@@ -100,6 +103,7 @@ func TestCacheProcessorPreProcess(t *testing.T) {
 				Context:         ctx,
 				Type:            test.requestType,
 				OriginalRequest: test.originalRequest,
+				CurrentRequest:  test.originalRequest,
 			}
 
 			outcome, err := processor.PreProcess(rc)
@@ -129,6 +133,7 @@ func TestCacheProcessorPostProcess(t *testing.T) {
 		mockSetup       func(mock redismock.ClientMock)
 		originalRequest proto.Message
 		currentResponse proto.Message
+		failedSources   []string
 		wantOutcome     dispatcher.Outcome
 		wantErr         bool
 	}{
@@ -138,7 +143,8 @@ func TestCacheProcessorPostProcess(t *testing.T) {
 			mockSetup: func(mock redismock.ClientMock) {
 				request := &pbv2.NodeRequest{Nodes: []string{"testNode"}}
 				response := &pbv2.NodeResponse{Data: map[string]*pbv2.LinkedGraph{"testNode": {}}}
-				key, _ := generateCacheKey(request)
+				key, _ := GenerateCacheKey(request)
+				key = typedKey(dispatcher.TypeNode, key)
 				anyMsg, _ := anypb.New(response)
 				marshaled, _ := proto.Marshal(anyMsg)
 				cached, _ := util.Zip(marshaled)
@@ -155,7 +161,8 @@ func TestCacheProcessorPostProcess(t *testing.T) {
 			mockSetup: func(mock redismock.ClientMock) {
 				request := &pbv2.NodeRequest{Nodes: []string{"testNode"}}
 				response := &pbv2.NodeResponse{Data: map[string]*pbv2.LinkedGraph{"testNode": {}}}
-				key, _ := generateCacheKey(request)
+				key, _ := GenerateCacheKey(request)
+				key = typedKey(dispatcher.TypeNode, key)
 				anyMsg, _ := anypb.New(response)
 				marshaled, _ := proto.Marshal(anyMsg)
 				cached, _ := util.Zip(marshaled)
@@ -175,6 +182,16 @@ func TestCacheProcessorPostProcess(t *testing.T) {
 			wantOutcome:     dispatcher.Continue,
 			wantErr:         false,
 		},
+		{
+			name:            "Partial Response",
+			requestType:     dispatcher.TypeNode,
+			mockSetup:       func(mock redismock.ClientMock) {},
+			originalRequest: &pbv2.NodeRequest{Nodes: []string{"testNode"}},
+			currentResponse: &pbv2.NodeResponse{Data: map[string]*pbv2.LinkedGraph{"testNode": {}}},
+			failedSources:   []string{"remote-api.datacommons.org"},
+			wantOutcome:     dispatcher.Continue,
+			wantErr:         false,
+		},
 	}
 
 	for _, test := range tests {
@@ -190,7 +207,9 @@ func TestCacheProcessorPostProcess(t *testing.T) {
 				Context:         ctx,
 				Type:            test.requestType,
 				OriginalRequest: test.originalRequest,
+				CurrentRequest:  test.originalRequest,
 				CurrentResponse: test.currentResponse,
+				FailedSources:   test.failedSources,
 			}
 
 			outcome, err := processor.PostProcess(rc)
@@ -209,6 +228,48 @@ func TestCacheProcessorPostProcess(t *testing.T) {
 	}
 }
 
+func TestCacheProcessorInvalidate(t *testing.T) {
+	ctx := context.Background()
+	rdb, mock := redismock.NewClientMock()
+	client := newCacheClient(rdb, time.Minute)
+	client.SetVersion("old")
+	processor := NewCacheProcessor(client)
+
+	mock.ExpectScan(0, "mixer:v:old:request:*", purgeBatchSize).SetVal([]string{"mixer:v:old:request:1"}, 0)
+	mock.ExpectDel("mixer:v:old:request:1").SetVal(1)
+	assert.NoError(t, processor.Invalidate(ctx, "new"))
+	assert.Equal(t, "new", client.Version())
+
+	mock.ExpectScan(0, "mixer:v:new:request:*", purgeBatchSize).SetErr(errors.New("redis error"))
+	assert.Error(t, processor.Invalidate(ctx, "new"))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCacheProcessorDisabledType(t *testing.T) {
+	rdb, mock := redismock.NewClientMock()
+	client := newCacheClient(rdb, time.Minute)
+	client.expirations = map[dispatcher.RequestType]time.Duration{dispatcher.TypeObservation: -1}
+	processor := NewCacheProcessor(client)
+
+	// No Redis calls are expected.
+	rc := &dispatcher.RequestContext{
+		Context:         context.Background(),
+		Type:            dispatcher.TypeObservation,
+		OriginalRequest: &pbv2.ObservationRequest{},
+		CurrentRequest:  &pbv2.ObservationRequest{},
+		CurrentResponse: &pbv2.ObservationResponse{},
+	}
+	outcome, err := processor.PreProcess(rc)
+	assert.NoError(t, err)
+	assert.Equal(t, dispatcher.Continue, outcome)
+	outcome, err = processor.PostProcess(rc)
+	assert.NoError(t, err)
+	assert.Equal(t, dispatcher.Continue, outcome)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestNewEmptyResponse(t *testing.T) {
 	// Test cases
 	tests := []struct {
@@ -0,0 +1,103 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"sync"
+	"time"
+)
+
+// circuitBreaker fails calls fast while the remote mixer is down.
+//
+// It opens after a number of consecutive failures. Once open, calls are rejected until the cooldown
+// has passed, after which a single call is let through to probe the remote mixer: the breaker closes
+// if it succeeds and opens for another cooldown otherwise.
+// A nil circuitBreaker lets every call through.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	if threshold <= 0 {
+		return nil
+	}
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// breakerToken identifies an allowed call when its outcome is recorded.
+type breakerToken struct {
+	// Whether the call probes the remote mixer after the cooldown.
+	probe bool
+}
+
+// allow returns whether a call can be made. Each allowed call must be followed by a call to done
+// with the returned token.
+func (b *circuitBreaker) allow() (breakerToken, bool) {
+	if b == nil {
+		return breakerToken{}, true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return breakerToken{}, true
+	}
+	if b.probing || b.now().Before(b.openUntil) {
+		return breakerToken{}, false
+	}
+	b.probing = true
+	return breakerToken{probe: true}, true
+}
+
+// done records the outcome of an allowed call. Calls that neither succeeded nor failed,
+// e.g. cancelled by the caller, don't change the state of the breaker. Only the outcome of the probe
+// lets another probe through, not those of calls allowed before the breaker opened.
+func (b *circuitBreaker) done(token breakerToken, outcome callOutcome) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if token.probe {
+		b.probing = false
+	}
+	switch outcome {
+	case callSucceeded:
+		b.failures = 0
+	case callFailed:
+		b.failures++
+		if b.failures >= b.threshold {
+			b.openUntil = b.now().Add(b.cooldown)
+		}
+	}
+}
+
+// callOutcome is the outcome of a call as seen by the circuit breaker.
+type callOutcome int
+
+const (
+	// The remote mixer responded, possibly with an error about the request.
+	callSucceeded callOutcome = iota
+	// The remote mixer is unavailable or failed.
+	callFailed
+	// The call was cancelled before the remote mixer responded.
+	callAbandoned
+)
@@ -16,64 +16,271 @@
 package remote
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
 	"net/http"
+	"time"
 
+	pb "github.com/datacommonsorg/mixer/internal/proto"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
 	"github.com/datacommonsorg/mixer/internal/server/resource"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Transport is the protocol used to call the remote mixer.
+type Transport string
+
+const (
+	// TransportHTTP calls the REST APIs of the remote mixer with JSON.
+	TransportHTTP Transport = "http"
+	// TransportGRPC calls the RPCs of the remote mixer with compressed protos, which is faster for large responses.
+	TransportGRPC Transport = "grpc"
+)
 
-	"github.com/datacommonsorg/mixer/internal/util"
+// Defaults of the Config settings.
+const (
+	DefaultTimeout          = 30 * time.Second
+	DefaultMaxAttempts      = 3
+	DefaultInitialBackoff   = 100 * time.Millisecond
+	DefaultMaxBackoff       = 2 * time.Second
+	DefaultBreakerThreshold = 5
+	DefaultBreakerCooldown  = 10 * time.Second
 )
 
+// Config is the configuration of the calls of a RemoteClient. Settings that aren't set use the defaults.
+type Config struct {
+	// Protocol of the calls, http by default.
+	Transport Transport `yaml:"transport"`
+	// Address of the gRPC endpoint of the remote mixer, e.g. mixer.example.org:443. Required for the grpc transport.
+	GRPCAddress string `yaml:"grpc_address"`
+	// Whether the grpc transport connects without TLS, e.g. to a mixer in the same cluster.
+	GRPCInsecure bool `yaml:"grpc_insecure"`
+	// Timeout of each attempt of a call, e.g. 5s.
+	Timeout time.Duration `yaml:"timeout"`
+	// Max number of attempts of a call, including the first one. 1 disables retries.
+	// Calls are retried when the remote mixer is unavailable or an attempt times out.
+	MaxAttempts int `yaml:"max_attempts"`
+	// Max wait before the first retry, doubled for each retry up to MaxBackoff.
+	// Retries wait for a random duration up to the max so that callers don't retry in sync.
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+	// Number of consecutive failed attempts after which calls fail fast for BreakerCooldown.
+	// A negative value disables the circuit breaker.
+	BreakerThreshold int           `yaml:"breaker_threshold"`
+	BreakerCooldown  time.Duration `yaml:"breaker_cooldown"`
+}
+
+// withDefaults returns a copy of the config with the defaults of the settings that aren't set.
+func (cfg *Config) withDefaults() *Config {
+	result := &Config{}
+	if cfg != nil {
+		*result = *cfg
+	}
+	if result.Transport == "" {
+		result.Transport = TransportHTTP
+	}
+	if result.Timeout == 0 {
+		result.Timeout = DefaultTimeout
+	}
+	if result.MaxAttempts == 0 {
+		result.MaxAttempts = DefaultMaxAttempts
+	}
+	if result.InitialBackoff == 0 {
+		result.InitialBackoff = DefaultInitialBackoff
+	}
+	if result.MaxBackoff == 0 {
+		result.MaxBackoff = DefaultMaxBackoff
+	}
+	if result.BreakerThreshold == 0 {
+		result.BreakerThreshold = DefaultBreakerThreshold
+	}
+	if result.BreakerCooldown == 0 {
+		result.BreakerCooldown = DefaultBreakerCooldown
+	}
+	return result
+}
+
 // RemoteClient encapsulates a client for a Remote Mixer.
 type RemoteClient struct {
-	metadata   *resource.Metadata
-	httpClient *http.Client
-	id         string
+	transport transport
+	id        string
+	config    *Config
+	breaker   *circuitBreaker
 }
 
-// NewRemoteClient creates a new RemoteClient.
+// NewRemoteClient creates a new RemoteClient with the default Config.
 func NewRemoteClient(metadata *resource.Metadata) (*RemoteClient, error) {
+	return NewRemoteClientFromConfig(metadata, nil)
+}
+
+// NewRemoteClientFromConfig creates a new RemoteClient whose calls are configured by config, which can be nil.
+func NewRemoteClientFromConfig(metadata *resource.Metadata, config *Config) (*RemoteClient, error) {
 	if metadata.RemoteMixerDomain == "" || metadata.RemoteMixerAPIKey == "" {
 		return nil, fmt.Errorf("error creating remote client: please ensure that the remote mixer domain and API key are set")
 	}
+	config = config.withDefaults()
+	var t transport
+	switch config.Transport {
+	case TransportHTTP:
+		t = &httpTransport{metadata: metadata, httpClient: &http.Client{}}
+	case TransportGRPC:
+		grpcTransport, err := newGRPCTransport(metadata, config)
+		if err != nil {
+			return nil, err
+		}
+		t = grpcTransport
+	default:
+		return nil, fmt.Errorf("error creating remote client: unknown transport %q", config.Transport)
+	}
 	return &RemoteClient{
-		metadata:   metadata,
-		httpClient: &http.Client{},
-		id:         metadata.RemoteMixerDomain,
+		transport: t,
+		// The id doesn't depend on the transport, so that responses are handled the same way.
+		id:      metadata.RemoteMixerDomain,
+		config:  config,
+		breaker: newCircuitBreaker(config.BreakerThreshold, config.BreakerCooldown),
 	}, nil
 }
 
-func (rc *RemoteClient) Node(req *pbv2.NodeRequest) (*pbv2.NodeResponse, error) {
+// Close releases the connection of the client.
+func (rc *RemoteClient) Close() error {
+	return rc.transport.close()
+}
+
+// fetch calls an API of the remote mixer. All the APIs called by the client only read data,
+// so calls are retried.
+func (rc *RemoteClient) fetch(ctx context.Context, apiPath string, in, out proto.Message) error {
+	var err error
+	for attempt := 0; attempt < rc.config.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			if sleepErr := sleep(ctx, rc.backoff(attempt)); sleepErr != nil {
+				return status.FromContextError(sleepErr).Err()
+			}
+		}
+		token, ok := rc.breaker.allow()
+		if !ok {
+			return status.Errorf(codes.Unavailable, "remote mixer %s is unavailable after repeated failures", rc.id)
+		}
+
+		attemptCtx, cancel := context.WithTimeout(ctx, rc.config.Timeout)
+		err = rc.transport.call(attemptCtx, apiPath, in, out)
+		cancel()
+		if err == nil {
+			rc.breaker.done(token, callSucceeded)
+			return nil
+		}
+		if ctx.Err() != nil {
+			rc.breaker.done(token, callAbandoned)
+			return err
+		}
+		if !isRemoteFailure(err) {
+			rc.breaker.done(token, callSucceeded)
+			return err
+		}
+		rc.breaker.done(token, callFailed)
+		if !isRetryable(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// backoff returns the wait before a retry, with full jitter.
+func (rc *RemoteClient) backoff(attempt int) time.Duration {
+	backoff := rc.config.InitialBackoff
+	for i := 1; i < attempt && backoff < rc.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > rc.config.MaxBackoff {
+		backoff = rc.config.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(backoff)))
+}
+
+// sleep waits for a duration, or returns the error of the context if it's done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isRemoteFailure returns whether an error means that the remote mixer is down or failing,
+// as opposed to an error about the request.
+func isRemoteFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown:
+		return true
+	}
+	return false
+}
+
+// isRetryable returns whether a call that failed with an error can succeed if retried.
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+func (rc *RemoteClient) Node(ctx context.Context, req *pbv2.NodeRequest) (*pbv2.NodeResponse, error) {
 	resp := &pbv2.NodeResponse{}
-	err := util.FetchRemote(rc.metadata, rc.httpClient, "/v2/node", req, resp)
+	err := rc.fetch(ctx, "/v2/node", req, resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
-func (rc *RemoteClient) Observation(req *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
+func (rc *RemoteClient) Observation(ctx context.Context, req *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
 	resp := &pbv2.ObservationResponse{}
-	err := util.FetchRemote(rc.metadata, rc.httpClient, "/v2/observation", req, resp)
+	err := rc.fetch(ctx, "/v2/observation", req, resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
-func (rc *RemoteClient) NodeSearch(req *pbv2.NodeSearchRequest) (*pbv2.NodeSearchResponse, error) {
+func (rc *RemoteClient) NodeSearch(ctx context.Context, req *pbv2.NodeSearchRequest) (*pbv2.NodeSearchResponse, error) {
 	resp := &pbv2.NodeSearchResponse{}
-	err := util.FetchRemote(rc.metadata, rc.httpClient, "/v3/node_search", req, resp)
+	err := rc.fetch(ctx, "/v3/node_search", req, resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
-func (rc *RemoteClient) Resolve(req *pbv2.ResolveRequest) (*pbv2.ResolveResponse, error) {
+func (rc *RemoteClient) Resolve(ctx context.Context, req *pbv2.ResolveRequest) (*pbv2.ResolveResponse, error) {
 	resp := &pbv2.ResolveResponse{}
-	err := util.FetchRemote(rc.metadata, rc.httpClient, "/v2/resolve", req, resp)
+	err := rc.fetch(ctx, "/v2/resolve", req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (rc *RemoteClient) Event(ctx context.Context, req *pbv2.EventRequest) (*pbv2.EventResponse, error) {
+	resp := &pbv2.EventResponse{}
+	err := rc.fetch(ctx, "/v2/event", req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (rc *RemoteClient) Sparql(ctx context.Context, req *pb.SparqlRequest) (*pb.QueryResponse, error) {
+	resp := &pb.QueryResponse{}
+	err := rc.fetch(ctx, "/v2/sparql", req, resp)
 	if err != nil {
 		return nil, err
 	}
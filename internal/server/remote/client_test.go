@@ -0,0 +1,213 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/resource"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestClient returns a client of a server that responds with the given HTTP statuses in turn,
+// and the number of requests the server received.
+func newTestClient(t *testing.T, config *Config, statuses ...int) (*RemoteClient, *atomic.Int64) {
+	requests := &atomic.Int64{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := int(requests.Add(1)) - 1
+		if i >= len(statuses) {
+			i = len(statuses) - 1
+		}
+		w.WriteHeader(statuses[i])
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+	client, err := NewRemoteClientFromConfig(&resource.Metadata{RemoteMixerDomain: server.URL, RemoteMixerAPIKey: "key"}, config)
+	if err != nil {
+		t.Fatalf("NewRemoteClientFromConfig error: %v", err)
+	}
+	return client, requests
+}
+
+func TestRemoteClientRetries(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		config       *Config
+		statuses     []int
+		wantCode     codes.Code
+		wantRequests int64
+	}{
+		{
+			name:         "retried until success",
+			config:       &Config{InitialBackoff: time.Millisecond},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			wantCode:     codes.OK,
+			wantRequests: 3,
+		},
+		{
+			name:         "attempts exhausted",
+			config:       &Config{InitialBackoff: time.Millisecond, MaxAttempts: 2},
+			statuses:     []int{http.StatusServiceUnavailable},
+			wantCode:     codes.Unavailable,
+			wantRequests: 2,
+		},
+		{
+			name:         "invalid request",
+			config:       &Config{InitialBackoff: time.Millisecond},
+			statuses:     []int{http.StatusBadRequest},
+			wantCode:     codes.InvalidArgument,
+			wantRequests: 1,
+		},
+		{
+			name:         "server error",
+			config:       &Config{InitialBackoff: time.Millisecond},
+			statuses:     []int{http.StatusInternalServerError, http.StatusOK},
+			wantCode:     codes.Internal,
+			wantRequests: 1,
+		},
+	} {
+		client, requests := newTestClient(t, tc.config, tc.statuses...)
+		_, err := client.Node(context.Background(), &pbv2.NodeRequest{Nodes: []string{"geoId/06"}, Property: "->name"})
+		if got := status.Code(err); got != tc.wantCode {
+			t.Errorf("Node (%s) code = %v, want %v: %v", tc.name, got, tc.wantCode, err)
+		}
+		if got := requests.Load(); got != tc.wantRequests {
+			t.Errorf("Node (%s) requests = %d, want %d", tc.name, got, tc.wantRequests)
+		}
+	}
+}
+
+func TestRemoteClientCancellation(t *testing.T) {
+	client, requests := newTestClient(t, &Config{InitialBackoff: time.Hour, MaxBackoff: time.Hour}, http.StatusServiceUnavailable)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// The call stops waiting for a retry when the context is done.
+	_, err := client.Node(ctx, &pbv2.NodeRequest{Nodes: []string{"geoId/06"}, Property: "->name"})
+	if got := status.Code(err); got != codes.DeadlineExceeded {
+		t.Errorf("Node code = %v, want DeadlineExceeded: %v", got, err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("Node requests = %d, want 1", got)
+	}
+}
+
+func TestRemoteClientCircuitBreaker(t *testing.T) {
+	client, requests := newTestClient(t, &Config{MaxAttempts: 1, BreakerThreshold: 2, BreakerCooldown: time.Hour}, http.StatusServiceUnavailable)
+	now := time.Now()
+	client.breaker.now = func() time.Time { return now }
+	call := func() error {
+		_, err := client.Node(context.Background(), &pbv2.NodeRequest{Nodes: []string{"geoId/06"}, Property: "->name"})
+		return err
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := call(); status.Code(err) != codes.Unavailable {
+			t.Errorf("Call %d code = %v, want Unavailable", i, status.Code(err))
+		}
+	}
+	// The breaker opens after 2 failures, so the third call fails fast.
+	if got := requests.Load(); got != 2 {
+		t.Errorf("Requests = %d, want 2", got)
+	}
+
+	// After the cooldown, a probe is let through and opens the breaker again when it fails.
+	now = now.Add(2 * time.Hour)
+	_ = call()
+	_ = call()
+	if got := requests.Load(); got != 3 {
+		t.Errorf("Requests after cooldown = %d, want 3", got)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker(1, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	token, ok := breaker.allow()
+	if !ok {
+		t.Fatalf("allow of a closed breaker = false, want true")
+	}
+	breaker.done(token, callFailed)
+	if _, ok := breaker.allow(); ok {
+		t.Errorf("allow of an open breaker = true, want false")
+	}
+
+	now = now.Add(2 * time.Minute)
+	probe, ok := breaker.allow()
+	if !ok {
+		t.Fatalf("allow after the cooldown = false, want true")
+	}
+	// Only one probe is let through at a time.
+	if _, ok := breaker.allow(); ok {
+		t.Errorf("allow while probing = true, want false")
+	}
+	// An abandoned probe lets another one through.
+	breaker.done(probe, callAbandoned)
+	probe, ok = breaker.allow()
+	if !ok {
+		t.Fatalf("allow after an abandoned probe = false, want true")
+	}
+	breaker.done(probe, callSucceeded)
+	if _, ok := breaker.allow(); !ok {
+		t.Errorf("allow after a successful probe = false, want true")
+	}
+	if _, ok := breaker.allow(); !ok {
+		t.Errorf("allow after a successful probe = false, want true")
+	}
+
+	if _, ok := newCircuitBreaker(-1, time.Minute).allow(); !ok {
+		t.Errorf("allow of a disabled breaker = false, want true")
+	}
+}
+
+func TestCircuitBreakerSlowCall(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker(1, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	// A slow call is allowed before the breaker opens.
+	slow, ok := breaker.allow()
+	if !ok {
+		t.Fatalf("allow of a closed breaker = false, want true")
+	}
+	failed, _ := breaker.allow()
+	breaker.done(failed, callFailed)
+
+	now = now.Add(2 * time.Minute)
+	probe, ok := breaker.allow()
+	if !ok {
+		t.Fatalf("allow after the cooldown = false, want true")
+	}
+	// The slow call fails while the breaker is half-open, the probe is still in flight.
+	breaker.done(slow, callFailed)
+	now = now.Add(2 * time.Minute)
+	if _, ok := breaker.allow(); ok {
+		t.Errorf("allow after the slow call while probing = true, want false")
+	}
+
+	breaker.done(probe, callSucceeded)
+	if _, ok := breaker.allow(); !ok {
+		t.Errorf("allow after a successful probe = false, want true")
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 
+	pb "github.com/datacommonsorg/mixer/internal/proto"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
 	"github.com/datacommonsorg/mixer/internal/server/datasource"
 )
@@ -42,17 +43,25 @@ func (rds *RemoteDataSource) Id() string {
 }
 
 func (rds *RemoteDataSource) Node(ctx context.Context, req *pbv2.NodeRequest) (*pbv2.NodeResponse, error) {
-	return rds.client.Node(req)
+	return rds.client.Node(ctx, req)
 }
 
 func (rds *RemoteDataSource) Observation(ctx context.Context, req *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
-	return rds.client.Observation(req)
+	return rds.client.Observation(ctx, req)
 }
 
 func (rds *RemoteDataSource) NodeSearch(ctx context.Context, req *pbv2.NodeSearchRequest) (*pbv2.NodeSearchResponse, error) {
-	return rds.client.NodeSearch(req)
+	return rds.client.NodeSearch(ctx, req)
 }
 
 func (rds *RemoteDataSource) Resolve(ctx context.Context, req *pbv2.ResolveRequest) (*pbv2.ResolveResponse, error) {
-	return rds.client.Resolve(req)
+	return rds.client.Resolve(ctx, req)
+}
+
+func (rds *RemoteDataSource) Event(ctx context.Context, req *pbv2.EventRequest) (*pbv2.EventResponse, error) {
+	return rds.client.Event(ctx, req)
+}
+
+func (rds *RemoteDataSource) Sparql(ctx context.Context, req *pb.SparqlRequest) (*pb.QueryResponse, error) {
+	return rds.client.Sparql(ctx, req)
 }
@@ -0,0 +1,108 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	pbs "github.com/datacommonsorg/mixer/internal/proto/service"
+	"github.com/datacommonsorg/mixer/internal/server/resource"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxGRPCResponseBytes is the max size of a response received with the grpc transport.
+// Observation responses for many entities can be much larger than the gRPC default of 4MB.
+const maxGRPCResponseBytes = 256 << 20
+
+// grpcMethods are the RPCs of the REST APIs called by the client.
+var grpcMethods = map[string]string{
+	"/v2/node":        pbs.Mixer_V2Node_FullMethodName,
+	"/v2/observation": pbs.Mixer_V2Observation_FullMethodName,
+	"/v3/node_search": pbs.Mixer_V3NodeSearch_FullMethodName,
+	"/v2/resolve":     pbs.Mixer_V2Resolve_FullMethodName,
+	"/v2/event":       pbs.Mixer_V2Event_FullMethodName,
+	"/v2/sparql":      pbs.Mixer_V2Sparql_FullMethodName,
+}
+
+// transport sends the calls of a RemoteClient to the remote mixer.
+type transport interface {
+	// call calls the API of the remote mixer with a REST path, e.g. /v2/node.
+	// Errors are gRPC status errors.
+	call(ctx context.Context, apiPath string, in, out proto.Message) error
+	close() error
+}
+
+// httpTransport calls the REST APIs of the remote mixer.
+type httpTransport struct {
+	metadata   *resource.Metadata
+	httpClient *http.Client
+}
+
+func (t *httpTransport) call(ctx context.Context, apiPath string, in, out proto.Message) error {
+	return util.FetchRemoteWithContext(ctx, t.metadata, t.httpClient, apiPath, in, out)
+}
+
+func (t *httpTransport) close() error {
+	t.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// grpcTransport calls the RPCs of the remote mixer, which serve the same responses as the REST APIs.
+type grpcTransport struct {
+	conn   *grpc.ClientConn
+	apiKey string
+}
+
+func newGRPCTransport(md *resource.Metadata, config *Config) (*grpcTransport, error) {
+	if config.GRPCAddress == "" {
+		return nil, fmt.Errorf("error creating remote client: the gRPC address of the remote mixer is required for the grpc transport")
+	}
+	creds := credentials.NewClientTLSFromCert(nil, "")
+	if config.GRPCInsecure {
+		creds = insecure.NewCredentials()
+	}
+	// The connection is established lazily, so this doesn't fail if the remote mixer is down.
+	conn, err := grpc.Dial(config.GRPCAddress,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name), grpc.MaxCallRecvMsgSize(maxGRPCResponseBytes)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating remote client: %w", err)
+	}
+	return &grpcTransport{conn: conn, apiKey: md.RemoteMixerAPIKey}, nil
+}
+
+func (t *grpcTransport) call(ctx context.Context, apiPath string, in, out proto.Message) error {
+	method, ok := grpcMethods[apiPath]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "no RPC for remote mixer API %s", apiPath)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", t.apiKey)
+	return t.conn.Invoke(ctx, method, in, out)
+}
+
+func (t *grpcTransport) close() error {
+	return t.conn.Close()
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbs "github.com/datacommonsorg/mixer/internal/proto/service"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/resource"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func testObservationResponse() *pbv2.ObservationResponse {
+	return &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{
+			"Count_Person": {ByEntity: map[string]*pbv2.EntityObservation{
+				"geoId/06": {OrderedFacets: []*pbv2.FacetObservation{{
+					FacetId:      "1",
+					Observations: []*pb.PointStat{{Date: "2020", Value: proto.Float64(39538223)}},
+				}}},
+			}},
+		},
+		Facets: map[string]*pb.Facet{"1": {ImportName: "CensusPEP"}},
+	}
+}
+
+// testMixerServer serves a fixed observation response and fails node requests.
+type testMixerServer struct {
+	pbs.UnimplementedMixerServer
+	apiKeys  []string
+	requests atomic.Int64
+}
+
+func (s *testMixerServer) V2Observation(ctx context.Context, in *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
+	s.requests.Add(1)
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.apiKeys = md.Get("x-api-key")
+	return testObservationResponse(), nil
+}
+
+func (s *testMixerServer) V2Node(ctx context.Context, in *pbv2.NodeRequest) (*pbv2.NodeResponse, error) {
+	s.requests.Add(1)
+	return nil, status.Errorf(codes.NotFound, "no node %v", in.GetNodes())
+}
+
+func TestGRPCTransport(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Listen error: %v", err)
+	}
+	srv := grpc.NewServer()
+	mixer := &testMixerServer{}
+	pbs.RegisterMixerServer(srv, mixer)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := protojson.Marshal(testObservationResponse())
+		_, _ = w.Write(data)
+	}))
+	defer httpServer.Close()
+
+	md := &resource.Metadata{RemoteMixerDomain: httpServer.URL, RemoteMixerAPIKey: "key"}
+	grpcClient, err := NewRemoteClientFromConfig(md, &Config{Transport: TransportGRPC, GRPCAddress: lis.Addr().String(), GRPCInsecure: true})
+	if err != nil {
+		t.Fatalf("NewRemoteClientFromConfig error: %v", err)
+	}
+	defer grpcClient.Close()
+	httpClient, err := NewRemoteClient(md)
+	if err != nil {
+		t.Fatalf("NewRemoteClient error: %v", err)
+	}
+	defer httpClient.Close()
+
+	// Both transports serve the same responses from the same source.
+	req := &pbv2.ObservationRequest{
+		Variable: &pbv2.DcidOrExpression{Dcids: []string{"Count_Person"}},
+		Entity:   &pbv2.DcidOrExpression{Dcids: []string{"geoId/06"}},
+		Select:   []string{"variable", "entity", "date", "value"},
+	}
+	grpcSource, httpSource := NewRemoteDataSource(grpcClient), NewRemoteDataSource(httpClient)
+	if grpcSource.Id() != httpSource.Id() {
+		t.Errorf("Source ids = %s and %s, want the same id", grpcSource.Id(), httpSource.Id())
+	}
+	grpcResp, err := grpcSource.Observation(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Observation error (grpc): %v", err)
+	}
+	httpResp, err := httpSource.Observation(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Observation error (http): %v", err)
+	}
+	if diff := cmp.Diff(grpcResp, httpResp, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+	if diff := cmp.Diff(mixer.apiKeys, []string{"key"}); diff != "" {
+		t.Errorf("Unexpected API key diff %v", diff)
+	}
+
+	// Errors of the remote mixer keep their code and aren't retried.
+	_, err = grpcSource.Node(context.Background(), &pbv2.NodeRequest{Nodes: []string{"geoId/00"}, Property: "->"})
+	if got := status.Code(err); got != codes.NotFound {
+		t.Errorf("Node code = %v, want NotFound: %v", got, err)
+	}
+	if got := mixer.requests.Load(); got != 2 {
+		t.Errorf("Requests = %d, want 2", got)
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/datacommonsorg/mixer/internal/server/cache"
 	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
 	"github.com/datacommonsorg/mixer/internal/server/resource"
+	"github.com/datacommonsorg/mixer/internal/sqldb"
 	"github.com/datacommonsorg/mixer/internal/store"
 	"github.com/datacommonsorg/mixer/internal/store/bigtable"
 	"github.com/datacommonsorg/mixer/internal/translator/solver"
@@ -48,6 +49,8 @@ type Server struct {
 	mapsClient *maps.Client
 	httpClient *http.Client
 	dispatcher *dispatcher.Dispatcher
+	// Hash of the V3 config, part of the data version of cached responses.
+	configHash string
 }
 
 func (s *Server) updateBranchTable(ctx context.Context, branchTableName string) error {
@@ -65,7 +68,33 @@ func (s *Server) updateBranchTable(ctx context.Context, branchTableName string)
 	s.store.BtGroup.UpdateBranchTable(
 		bigtable.NewTable(branchTableName, branchTable, false /*isCustom=*/))
 	log.Printf("Updated branch table to use %s", branchTableName)
-	return nil
+	return s.updateDataVersion(ctx)
+}
+
+// InitDataVersion sets the hash of the V3 config and namespaces cached responses by the current data version.
+func (s *Server) InitDataVersion(ctx context.Context, configHash string) error {
+	s.configHash = configHash
+	return s.updateDataVersion(ctx)
+}
+
+// updateDataVersion invalidates cached responses of other data versions.
+// It's called whenever the served data changes.
+func (s *Server) updateDataVersion(ctx context.Context) error {
+	version := &dispatcher.DataVersion{ConfigHash: s.configHash}
+	if s.store.BtGroup != nil {
+		version.BranchTable = s.store.BtGroup.BranchTableName()
+	}
+	if sqldb.IsConnected(&s.store.SQLClient) {
+		// The imports table is optional, so the version falls back to the other fields without it.
+		imports, err := s.store.SQLClient.GetAllImports(ctx)
+		if err != nil {
+			log.Printf("Failed to get SQL imports for the data version: %v", err)
+		} else if len(imports) > 0 {
+			// Imports are sorted from newest to oldest.
+			version.SQLImportedAt = imports[0].ImportedAt
+		}
+	}
+	return s.dispatcher.Invalidate(ctx, version.Key())
 }
 
 // NewMetadata initialize the metadata for translator.
@@ -0,0 +1,208 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package singleflight coalesces identical concurrent V3 requests.
+package singleflight
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/datacommonsorg/mixer/internal/server/datasources"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
+	"github.com/datacommonsorg/mixer/internal/server/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultTimeout is the default time limit of the shared handling of coalesced requests.
+const DefaultTimeout = time.Minute
+
+// Config is the configuration of a CoalescingProcessor.
+type Config struct {
+	// Time limit of the shared handling of coalesced requests, e.g. 30s. Defaults to DefaultTimeout.
+	// The shared handling doesn't stop when the request that started it is cancelled, so this bounds it instead.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// call is an in-flight request that identical requests wait on.
+type call struct {
+	// Closed once the result is published.
+	done chan struct{}
+	// Result of the request, set before done is closed.
+	response      proto.Message
+	failedSources []string
+	err           error
+	// Number of requests waiting on the call, guarded by CoalescingProcessor.mu.
+	waiting int
+}
+
+// leaderKey is the context key of the leaderState of the request that handles a call.
+type leaderKey struct {
+	processor *CoalescingProcessor
+}
+
+type leaderState struct {
+	key    string
+	call   *call
+	cancel context.CancelFunc
+	// Whether the result was published.
+	published bool
+}
+
+// CoalescingProcessor implements the dispatcher.Processor interface for coalescing identical concurrent requests.
+// The first request (the leader) is handled as usual, while identical requests that arrive before it's done
+// (the followers) wait for its response instead of being handled again.
+// Requests are identical if they have the same type and cache key, see redis.GenerateCacheKey.
+//
+// The leader is handled with a context that isn't cancelled with the leader's, so that one cancelled caller
+// doesn't fail the others. A cancelled follower stops waiting without affecting the leader.
+type CoalescingProcessor struct {
+	timeout time.Duration
+
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+func NewCoalescingProcessor(config *Config) *CoalescingProcessor {
+	timeout := DefaultTimeout
+	if config != nil && config.Timeout > 0 {
+		timeout = config.Timeout
+	}
+	return &CoalescingProcessor{timeout: timeout, calls: map[string]*call{}}
+}
+
+// InFlight returns the number of requests that are being handled for others to wait on.
+func (processor *CoalescingProcessor) InFlight() int {
+	processor.mu.Lock()
+	defer processor.mu.Unlock()
+	return len(processor.calls)
+}
+
+// Waiting returns the number of requests that are waiting for the response of an identical request.
+func (processor *CoalescingProcessor) Waiting() int {
+	processor.mu.Lock()
+	defer processor.mu.Unlock()
+	waiting := 0
+	for _, c := range processor.calls {
+		waiting += c.waiting
+	}
+	return waiting
+}
+
+// PreProcess waits for the response of an identical in-flight request, or makes the current request
+// the one that others wait on.
+func (processor *CoalescingProcessor) PreProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	cacheKey, err := redis.GenerateCacheKey(rc.CurrentRequest)
+	if err != nil {
+		// Log the error but continue processing.
+		log.Printf("Error generating coalescing key: %v", err)
+		return dispatcher.Continue, nil
+	}
+	key := string(rc.Type) + ":" + cacheKey
+
+	processor.mu.Lock()
+	if c, ok := processor.calls[key]; ok {
+		c.waiting++
+		processor.mu.Unlock()
+		return processor.wait(rc, c)
+	}
+	c := &call{done: make(chan struct{})}
+	processor.calls[key] = c
+	processor.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(detachedContext{rc.Context}, processor.timeout)
+	rc.Context = context.WithValue(ctx, leaderKey{processor}, &leaderState{key: key, call: c, cancel: cancel})
+	return dispatcher.Continue, nil
+}
+
+// wait serves a follower with the result of a call.
+func (processor *CoalescingProcessor) wait(rc *dispatcher.RequestContext, c *call) (dispatcher.Outcome, error) {
+	select {
+	case <-c.done:
+	case <-rc.Context.Done():
+		processor.mu.Lock()
+		c.waiting--
+		processor.mu.Unlock()
+		return dispatcher.Done, status.FromContextError(rc.Context.Err()).Err()
+	}
+	if c.err != nil {
+		return dispatcher.Done, c.err
+	}
+	if c.response == nil {
+		return dispatcher.Done, status.Error(codes.Internal, "coalesced request has no response")
+	}
+	rc.CurrentResponse = proto.Clone(c.response)
+	rc.FailedSources = c.failedSources
+	if len(c.failedSources) > 0 {
+		datasources.ReportFailedSources(rc.Context, c.failedSources)
+	}
+	return dispatcher.Done, nil
+}
+
+// PostProcess publishes the response of a leader to its followers.
+func (processor *CoalescingProcessor) PostProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	if leader, ok := rc.Context.Value(leaderKey{processor}).(*leaderState); ok && !leader.published {
+		var response proto.Message
+		if rc.CurrentResponse != nil {
+			response = proto.Clone(rc.CurrentResponse)
+		}
+		processor.publish(leader, response, rc.FailedSources, nil)
+	}
+	return dispatcher.Continue, nil
+}
+
+// Finish publishes the error of a leader that failed, and releases its context.
+// The context is only released here since the processors before this one still use it while post-processing.
+func (processor *CoalescingProcessor) Finish(rc *dispatcher.RequestContext, err error) {
+	leader, ok := rc.Context.Value(leaderKey{processor}).(*leaderState)
+	if !ok {
+		return
+	}
+	if !leader.published {
+		if err == nil {
+			err = status.Error(codes.Internal, "coalesced request was not completed")
+		}
+		processor.publish(leader, nil, nil, err)
+	}
+	leader.cancel()
+}
+
+// publish sets the result of a call and wakes up its followers.
+// Requests that arrive afterwards are handled again.
+func (processor *CoalescingProcessor) publish(leader *leaderState, response proto.Message, failedSources []string, err error) {
+	leader.call.response = response
+	leader.call.failedSources = failedSources
+	leader.call.err = err
+	leader.published = true
+
+	processor.mu.Lock()
+	delete(processor.calls, leader.key)
+	processor.mu.Unlock()
+	close(leader.call.done)
+}
+
+// detachedContext keeps the values of a context, e.g. the gRPC stream used to set trailers,
+// but not its deadline or cancellation.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+func (ctx detachedContext) Value(key any) any       { return ctx.parent.Value(key) }
@@ -0,0 +1,209 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package singleflight
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// blockingProcessor serves requests once released, like slow data sources.
+type blockingProcessor struct {
+	calls   atomic.Int64
+	started chan struct{}
+	release chan struct{}
+	err     error
+}
+
+func newBlockingProcessor(err error) *blockingProcessor {
+	return &blockingProcessor{started: make(chan struct{}, 10), release: make(chan struct{}), err: err}
+}
+
+func (p *blockingProcessor) PreProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	p.calls.Add(1)
+	p.started <- struct{}{}
+	select {
+	case <-p.release:
+	case <-rc.Context.Done():
+		return dispatcher.Done, rc.Context.Err()
+	}
+	if p.err != nil {
+		return dispatcher.Done, p.err
+	}
+	rc.CurrentResponse = &pbv2.NodeResponse{Data: map[string]*pbv2.LinkedGraph{"a": {}}}
+	return dispatcher.Done, nil
+}
+
+func (p *blockingProcessor) PostProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	return dispatcher.Continue, nil
+}
+
+type result struct {
+	response *pbv2.NodeResponse
+	err      error
+}
+
+// testDispatcher coalesces requests in front of a blocking processor.
+type testDispatcher struct {
+	*dispatcher.Dispatcher
+	coalescer *CoalescingProcessor
+	backend   *blockingProcessor
+}
+
+func newTestDispatcher(err error) *testDispatcher {
+	d := &testDispatcher{coalescer: NewCoalescingProcessor(nil), backend: newBlockingProcessor(err)}
+	var p1, p2 dispatcher.Processor = d.coalescer, d.backend
+	d.Dispatcher = dispatcher.NewDispatcher([]*dispatcher.Processor{&p1, &p2}, nil)
+	return d
+}
+
+// start sends a request in the background.
+func (d *testDispatcher) start(ctx context.Context, wg *sync.WaitGroup, results chan<- result) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		resp, err := d.Node(ctx, &pbv2.NodeRequest{Nodes: []string{"a"}, Property: "->name"})
+		results <- result{resp, err}
+	}()
+}
+
+// awaitFollowers waits until n requests wait for the leader, which is blocked until released.
+func (d *testDispatcher) awaitFollowers(n int) {
+	for d.coalescer.Waiting() < n {
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestCoalescingProcessor(t *testing.T) {
+	d := newTestDispatcher(nil)
+	const n = 20
+	results := make(chan result, n)
+	wg := &sync.WaitGroup{}
+
+	d.start(context.Background(), wg, results)
+	<-d.backend.started
+	for i := 1; i < n; i++ {
+		d.start(context.Background(), wg, results)
+	}
+	d.awaitFollowers(n - 1)
+	close(d.backend.release)
+	wg.Wait()
+	close(results)
+
+	for r := range results {
+		if r.err != nil {
+			t.Fatalf("Node error: %v", r.err)
+		}
+		if diff := cmp.Diff(r.response, &pbv2.NodeResponse{Data: map[string]*pbv2.LinkedGraph{"a": {}}}, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff %v", diff)
+		}
+	}
+	if got := d.backend.calls.Load(); got != 1 {
+		t.Errorf("Backend calls = %d, want 1", got)
+	}
+	if got := d.coalescer.InFlight(); got != 0 {
+		t.Errorf("InFlight = %d, want 0", got)
+	}
+}
+
+func TestCoalescingProcessorCancellation(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		// Whether the leader or the follower is cancelled.
+		cancelLeader bool
+	}{
+		{name: "cancelled follower"},
+		{name: "cancelled leader", cancelLeader: true},
+	} {
+		d := newTestDispatcher(nil)
+		leaderCtx, cancelLeader := context.WithCancel(context.Background())
+		followerCtx, cancelFollower := context.WithCancel(context.Background())
+		leaderResults, followerResults := make(chan result, 1), make(chan result, 1)
+		wg := &sync.WaitGroup{}
+
+		d.start(leaderCtx, wg, leaderResults)
+		<-d.backend.started
+		d.start(followerCtx, wg, followerResults)
+		d.awaitFollowers(1)
+
+		if tc.cancelLeader {
+			cancelLeader()
+			close(d.backend.release)
+			wg.Wait()
+			// The shared handling isn't cancelled with the leader.
+			if r := <-followerResults; r.err != nil || r.response == nil {
+				t.Errorf("Follower (%s) = %v, %v, want a response", tc.name, r.response, r.err)
+			}
+		} else {
+			cancelFollower()
+			if r := <-followerResults; status.Code(r.err) != codes.Canceled {
+				t.Errorf("Follower (%s) error = %v, want Canceled", tc.name, r.err)
+			}
+			close(d.backend.release)
+			wg.Wait()
+			if r := <-leaderResults; r.err != nil || r.response == nil {
+				t.Errorf("Leader (%s) = %v, %v, want a response", tc.name, r.response, r.err)
+			}
+		}
+		cancelLeader()
+		cancelFollower()
+		if got := d.backend.calls.Load(); got != 1 {
+			t.Errorf("Backend calls (%s) = %d, want 1", tc.name, got)
+		}
+	}
+}
+
+func TestCoalescingProcessorError(t *testing.T) {
+	wantErr := errors.New("source failed")
+	d := newTestDispatcher(wantErr)
+	results := make(chan result, 2)
+	wg := &sync.WaitGroup{}
+
+	d.start(context.Background(), wg, results)
+	<-d.backend.started
+	d.start(context.Background(), wg, results)
+	d.awaitFollowers(1)
+	close(d.backend.release)
+	wg.Wait()
+	close(results)
+
+	for r := range results {
+		if !errors.Is(r.err, wantErr) {
+			t.Errorf("Node error = %v, want %v", r.err, wantErr)
+		}
+	}
+	if got := d.coalescer.InFlight(); got != 0 {
+		t.Errorf("InFlight = %d, want 0", got)
+	}
+
+	// Later requests are handled again.
+	d.start(context.Background(), wg, make(chan result, 1))
+	<-d.backend.started
+	wg.Wait()
+	if got := d.backend.calls.Load(); got != 2 {
+		t.Errorf("Backend calls = %d, want 2", got)
+	}
+}
@@ -35,10 +35,15 @@ func newSpannerClient(client *spanner.Client) *SpannerClient {
 
 // NewSpannerClient creates a new SpannerClient from the config yaml string.
 func NewSpannerClient(ctx context.Context, spannerConfigYaml string) (*SpannerClient, error) {
-	cfg, err := createSpannerConfig(spannerConfigYaml)
+	cfg, err := ParseSpannerConfig(spannerConfigYaml)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create SpannerClient: %w", err)
 	}
+	return NewSpannerClientFromConfig(ctx, cfg)
+}
+
+// NewSpannerClientFromConfig creates a new SpannerClient from a config.
+func NewSpannerClientFromConfig(ctx context.Context, cfg *SpannerConfig) (*SpannerClient, error) {
 	client, err := createSpannerClient(ctx, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create SpannerClient: %w", err)
@@ -60,8 +65,8 @@ func createSpannerClient(ctx context.Context, cfg *SpannerConfig) (*spanner.Clie
 	return client, nil
 }
 
-// createSpannerConfig creates the config from specific yaml string.
-func createSpannerConfig(spannerConfigYaml string) (*SpannerConfig, error) {
+// ParseSpannerConfig creates the config from specific yaml string.
+func ParseSpannerConfig(spannerConfigYaml string) (*SpannerConfig, error) {
 	var cfg SpannerConfig
 	if err := yaml.Unmarshal([]byte(spannerConfigYaml), &cfg); err != nil {
 		return nil, fmt.Errorf("failed to create spanner config: %w", err)
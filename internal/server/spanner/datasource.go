@@ -18,9 +18,12 @@ import (
 	"context"
 	"fmt"
 
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv1 "github.com/datacommonsorg/mixer/internal/proto/v1"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
 	"github.com/datacommonsorg/mixer/internal/server/datasource"
 	v2 "github.com/datacommonsorg/mixer/internal/server/v2"
+	"github.com/datacommonsorg/mixer/internal/util"
 )
 
 // SpannerDataSource represents a data source that interacts with Spanner.
@@ -52,7 +55,7 @@ func (sds *SpannerDataSource) Node(ctx context.Context, req *pbv2.NodeRequest) (
 		return &pbv2.NodeResponse{}, nil
 	}
 	if len(arcs) > 1 {
-		return nil, fmt.Errorf("multiple arcs in node request")
+		return sds.getLinkedNodes(ctx, req.Nodes, arcs)
 	}
 	arc := arcs[0]
 
@@ -71,12 +74,44 @@ func (sds *SpannerDataSource) Node(ctx context.Context, req *pbv2.NodeRequest) (
 	}
 }
 
+// getLinkedNodes follows a chain of arcs from the nodes, one query per arc.
+// The response holds the results of the last arc for each node.
+func (sds *SpannerDataSource) getLinkedNodes(ctx context.Context, nodes []string, arcs []*v2.Arc) (*pbv2.NodeResponse, error) {
+	reached, err := v2.FollowArcs(nodes, arcs, func(nodes []string, arc *v2.Arc) (map[string][]string, error) {
+		edges, err := sds.client.GetNodeEdgesByID(ctx, nodes, arc)
+		if err != nil {
+			return nil, fmt.Errorf("error getting node edges: %v", err)
+		}
+		return nodeEdgesToReachedNodes(edges), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lastArc := arcs[len(arcs)-1]
+	if lastArc.IsNodePropertiesArc() {
+		props, err := sds.client.GetNodeProps(ctx, v2.ReachedNodes(reached), lastArc.Out)
+		if err != nil {
+			return nil, fmt.Errorf("error getting node properties: %v", err)
+		}
+		return reachedPropsToNodeResponse(reached, props), nil
+	}
+	edges, err := sds.client.GetNodeEdgesByID(ctx, v2.ReachedNodes(reached), lastArc)
+	if err != nil {
+		return nil, fmt.Errorf("error getting node edges: %v", err)
+	}
+	return reachedEdgesToNodeResponse(reached, edges), nil
+}
+
 // Observation retrieves observation data from Spanner.
 func (sds *SpannerDataSource) Observation(ctx context.Context, req *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
 	variables, entities, entityExpr := req.Variable.Dcids, req.Entity.Dcids, req.Entity.Expression
 	date := req.Date
+	valueFilter, err := util.ParseValueFilter(req.GetValue())
+	if err != nil {
+		return nil, err
+	}
 	var observations []*Observation
-	var err error
 
 	if entityExpr != "" {
 		containedInPlace, err := v2.ParseContainedInPlace(entityExpr)
@@ -96,7 +131,10 @@ func (sds *SpannerDataSource) Observation(ctx context.Context, req *pbv2.Observa
 
 	observations = filterObservationsByDateAndFacet(observations, date, req.Filter)
 
-	return observationsToObservationResponse(req, observations), nil
+	// Observations are stored as series, so values are filtered after the query.
+	response := observationsToObservationResponse(req, observations)
+	util.FilterObservationsByValue(response, valueFilter)
+	return response, nil
 }
 
 // NodeSearch searches nodes in the spanner graph.
@@ -110,5 +148,69 @@ func (sds *SpannerDataSource) NodeSearch(ctx context.Context, req *pbv2.NodeSear
 
 // Resolve searches for nodes in the graph.
 func (sds *SpannerDataSource) Resolve(ctx context.Context, req *pbv2.ResolveRequest) (*pbv2.ResolveResponse, error) {
-	return nil, fmt.Errorf("unimplemented")
+	inArc, outArc, err := v2.ParseResolveProperty(req.GetProperty())
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*ResolutionCandidate
+	switch {
+	case inArc.SingleProp == "geoCoordinate" && outArc.SingleProp == DCID_PROP:
+		// Coordinate to ID:
+		//   <-geoCoordinate->dcid
+		candidates, err = sds.client.ResolveByCoordinate(ctx, req.GetNodes(), inArc.Filter["typeOf"])
+		if err != nil {
+			return nil, fmt.Errorf("error resolving coordinates: %v", err)
+		}
+		sortCandidatesByPlaceType(candidates)
+	case inArc.SingleProp == "description" && outArc.SingleProp == DCID_PROP:
+		// Description (name) to ID:
+		//   <-description{typeOf:City}->dcid
+		candidates, err = sds.client.ResolveByDescription(ctx, req.GetNodes(), inArc.Filter["typeOf"])
+		if err != nil {
+			return nil, fmt.Errorf("error resolving descriptions: %v", err)
+		}
+	default:
+		// ID to ID:
+		//   <-wikidataId->dcid
+		candidates, err = sds.client.ResolveByID(ctx, req.GetNodes(), inArc.SingleProp, outArc.SingleProp)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving ids: %v", err)
+		}
+	}
+
+	return candidatesToResolveResponse(req.GetNodes(), candidates), nil
+}
+
+// Event retrieves events from the spanner graph.
+func (sds *SpannerDataSource) Event(ctx context.Context, req *pbv2.EventRequest) (*pbv2.EventResponse, error) {
+	eventProperty, err := v2.ParseEventProperty(req.GetProperty())
+	if err != nil {
+		return nil, err
+	}
+
+	if eventProperty.Date == "" {
+		dates, err := sds.client.GetEventDates(ctx, eventProperty.EventType, req.GetNode())
+		if err != nil {
+			return nil, fmt.Errorf("error getting event dates: %v", err)
+		}
+		return &pbv2.EventResponse{
+			EventCollectionDate: &pbv1.EventCollectionDate{Dates: dates},
+		}, nil
+	}
+
+	events, edges, err := sds.client.GetEventEdges(ctx, eventProperty.EventType, req.GetNode(), eventProperty.Date)
+	if err != nil {
+		return nil, fmt.Errorf("error getting events: %v", err)
+	}
+	return eventEdgesToEventResponse(events, edges, eventProperty.Filter), nil
+}
+
+// Sparql runs a Sparql query against the edges in the spanner graph.
+func (sds *SpannerDataSource) Sparql(ctx context.Context, req *pb.SparqlRequest) (*pb.QueryResponse, error) {
+	header, rows, err := sds.client.QueryTriples(ctx, req.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+	return rowsToQueryResponse(header, rows), nil
 }
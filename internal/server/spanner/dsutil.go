@@ -23,8 +23,10 @@ import (
 	"strconv"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv1 "github.com/datacommonsorg/mixer/internal/proto/v1"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
 	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	v2 "github.com/datacommonsorg/mixer/internal/server/v2"
 	"github.com/datacommonsorg/mixer/internal/util"
 
 	"google.golang.org/protobuf/proto"
@@ -122,6 +124,70 @@ func nodeEdgesToLinkedGraph(edges []*Edge) *pbv2.LinkedGraph {
 	return linkedGraph
 }
 
+// nodeEdgesToReachedNodes converts a map from subject id to its edges to a map from subject id to the object ids of its edges.
+func nodeEdgesToReachedNodes(edgesBySubjectID map[string][]*Edge) map[string][]string {
+	reached := map[string][]string{}
+	for subjectID, edges := range edgesBySubjectID {
+		for _, edge := range edges {
+			if edge.ObjectID != "" {
+				reached[subjectID] = append(reached[subjectID], edge.ObjectID)
+			}
+		}
+	}
+	return reached
+}
+
+// reachedEdgesToNodeResponse converts the edges of the nodes reached from each requested node to a NodeResponse proto.
+// Edges reached through more than one node are only included once.
+func reachedEdgesToNodeResponse(reached map[string][]string, edgesBySubjectID map[string][]*Edge) *pbv2.NodeResponse {
+	nodeResponse := &pbv2.NodeResponse{
+		Data: make(map[string]*pbv2.LinkedGraph),
+	}
+	type edgeKey struct {
+		predicate   string
+		objectID    string
+		objectValue string
+	}
+	for node, reachedNodes := range reached {
+		edges := []*Edge{}
+		seen := map[edgeKey]struct{}{}
+		for _, reachedNode := range reachedNodes {
+			for _, edge := range edgesBySubjectID[reachedNode] {
+				key := edgeKey{edge.Predicate, edge.ObjectID, edge.ObjectValue}
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				edges = append(edges, edge)
+			}
+		}
+		nodeResponse.Data[node] = nodeEdgesToLinkedGraph(edges)
+	}
+	return nodeResponse
+}
+
+// reachedPropsToNodeResponse converts the properties of the nodes reached from each requested node to a NodeResponse proto.
+func reachedPropsToNodeResponse(reached map[string][]string, propsBySubjectID map[string][]*Property) *pbv2.NodeResponse {
+	nodeResponse := &pbv2.NodeResponse{
+		Data: make(map[string]*pbv2.LinkedGraph),
+	}
+	for node, reachedNodes := range reached {
+		propSet := map[string]struct{}{}
+		for _, reachedNode := range reachedNodes {
+			for _, prop := range propsBySubjectID[reachedNode] {
+				propSet[prop.Predicate] = struct{}{}
+			}
+		}
+		linkedGraph := &pbv2.LinkedGraph{}
+		for prop := range propSet {
+			linkedGraph.Properties = append(linkedGraph.Properties, prop)
+		}
+		sort.Strings(linkedGraph.Properties)
+		nodeResponse.Data[node] = linkedGraph
+	}
+	return nodeResponse
+}
+
 func selectFieldsToQueryOptions(selectFields []string) queryOptions {
 	var qo queryOptions
 	for _, field := range selectFields {
@@ -430,3 +496,120 @@ func searchNodeToNodeSearchResult(node *SearchNode) *pbv2.NodeSearchResult {
 		},
 	}
 }
+
+// candidatesToResolveResponse converts resolution candidates to a resolve response with an entity for each node.
+func candidatesToResolveResponse(nodes []string, candidates []*ResolutionCandidate) *pbv2.ResolveResponse {
+	nodeToCandidates := map[string][]*ResolutionCandidate{}
+	for _, candidate := range candidates {
+		nodeToCandidates[candidate.Node] = append(nodeToCandidates[candidate.Node], candidate)
+	}
+
+	response := &pbv2.ResolveResponse{}
+	seenNodes := map[string]struct{}{}
+	for _, node := range nodes {
+		if _, ok := seenNodes[node]; ok {
+			continue
+		}
+		seenNodes[node] = struct{}{}
+
+		entity := &pbv2.ResolveResponse_Entity{
+			Node:       node,
+			Candidates: []*pbv2.ResolveResponse_Entity_Candidate{},
+		}
+		seenCandidates := map[string]struct{}{}
+		for _, candidate := range nodeToCandidates[node] {
+			if _, ok := seenCandidates[candidate.Candidate]; ok {
+				continue
+			}
+			seenCandidates[candidate.Candidate] = struct{}{}
+			entity.ResolvedIds = append(entity.ResolvedIds, candidate.Candidate)
+			entity.Candidates = append(entity.Candidates, &pbv2.ResolveResponse_Entity_Candidate{
+				Dcid:         candidate.Candidate,
+				DominantType: v2.GetDominantType(candidate.Types),
+			})
+		}
+		response.Entities = append(response.Entities, entity)
+	}
+
+	return response
+}
+
+// sortCandidatesByPlaceType sorts resolution candidates by the priority of their dominant place types.
+// Candidates whose types are not in the priority list are sorted last by dcid.
+func sortCandidatesByPlaceType(candidates []*ResolutionCandidate) {
+	priority := map[string]int{}
+	for i, placeType := range v2.ResolvedPlaceTypePriorityList {
+		priority[placeType] = i
+	}
+	getPriority := func(candidate *ResolutionCandidate) int {
+		if p, ok := priority[v2.GetDominantType(candidate.Types)]; ok {
+			return p
+		}
+		return len(priority)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		pi, pj := getPriority(candidates[i]), getPriority(candidates[j])
+		if pi != pj {
+			return pi < pj
+		}
+		return candidates[i].Candidate < candidates[j].Candidate
+	})
+}
+
+// eventEdgesToEventResponse converts the edges of events to an event collection response.
+// Events that don't meet the filter are dropped.
+func eventEdgesToEventResponse(events []string, edgesBySubjectID map[string][]*Edge, filter *v2.EventFilter) *pbv2.EventResponse {
+	collection := &pbv1.EventCollection{
+		Events:         []*pbv1.EventCollection_Event{},
+		ProvenanceInfo: map[string]*pbv1.EventCollection_ProvenanceInfo{},
+	}
+	for _, dcid := range events {
+		event := &pbv1.EventCollection_Event{Dcid: dcid}
+		propVals := map[string][]string{}
+		for _, edge := range edgesBySubjectID[dcid] {
+			if event.ProvenanceId == "" {
+				event.ProvenanceId = edge.Provenance
+			}
+			switch edge.Predicate {
+			case "typeOf":
+			case "affectedPlace":
+				event.Places = append(event.Places, edge.ObjectID)
+			case "startDate":
+				event.Dates = append(event.Dates, edge.ObjectValue)
+			default:
+				value := edge.ObjectValue
+				if edge.ObjectID != "" {
+					value = edge.ObjectID
+				}
+				propVals[edge.Predicate] = append(propVals[edge.Predicate], value)
+			}
+		}
+		if !filter.Keep(propVals) {
+			continue
+		}
+		for prop, vals := range propVals {
+			if event.PropVals == nil {
+				event.PropVals = map[string]*pbv1.EventCollection_ValList{}
+			}
+			event.PropVals[prop] = &pbv1.EventCollection_ValList{Vals: vals}
+		}
+		collection.Events = append(collection.Events, event)
+	}
+	return &pbv2.EventResponse{EventCollection: collection}
+}
+
+// rowsToQueryResponse converts rows of values to a query response.
+func rowsToQueryResponse(header []string, rows [][]string) *pb.QueryResponse {
+	response := &pb.QueryResponse{
+		Header: header,
+		Rows:   []*pb.QueryResponseRow{},
+	}
+	for _, row := range rows {
+		responseRow := &pb.QueryResponseRow{}
+		for _, value := range row {
+			responseRow.Cells = append(responseRow.Cells, &pb.QueryResponseCell{Value: value})
+		}
+		response.Rows = append(response.Rows, responseRow)
+	}
+	return response
+}
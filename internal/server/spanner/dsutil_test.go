@@ -0,0 +1,202 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanner
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestCandidatesToResolveResponse(t *testing.T) {
+	candidates := []*ResolutionCandidate{
+		{Node: "Q30", Candidate: "country/USA", Types: []string{"Country"}},
+		{Node: "Q110739", Candidate: "geoId/06085", Types: []string{"County", "AdministrativeArea2"}},
+		// Duplicate candidates are dropped.
+		{Node: "Q110739", Candidate: "geoId/06085", Types: []string{"County", "AdministrativeArea2"}},
+		{Node: "Q110739", Candidate: "wikidataId/Q110739", Types: []string{}},
+		// Candidates of nodes that weren't requested are dropped.
+		{Node: "Q99", Candidate: "geoId/06", Types: []string{"State"}},
+	}
+	want := &pbv2.ResolveResponse{
+		Entities: []*pbv2.ResolveResponse_Entity{
+			{
+				Node:        "Q110739",
+				ResolvedIds: []string{"geoId/06085", "wikidataId/Q110739"},
+				Candidates: []*pbv2.ResolveResponse_Entity_Candidate{
+					{Dcid: "geoId/06085", DominantType: "AdministrativeArea2"},
+					{Dcid: "wikidataId/Q110739"},
+				},
+			},
+			{
+				Node:        "Q30",
+				ResolvedIds: []string{"country/USA"},
+				Candidates: []*pbv2.ResolveResponse_Entity_Candidate{
+					{Dcid: "country/USA", DominantType: "Country"},
+				},
+			},
+			// Nodes without candidates have an entity without candidates.
+			{
+				Node:       "foo",
+				Candidates: []*pbv2.ResolveResponse_Entity_Candidate{},
+			},
+		},
+	}
+
+	// Entities are in the order of the requested nodes, without duplicates.
+	got := candidatesToResolveResponse([]string{"Q110739", "Q30", "foo", "Q30"}, candidates)
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+}
+
+func TestSortCandidatesByPlaceType(t *testing.T) {
+	candidates := []*ResolutionCandidate{
+		{Node: "37.42#-122.08", Candidate: "zip/94043", Types: []string{"CensusZipCodeTabulationArea"}},
+		{Node: "37.42#-122.08", Candidate: "country/USA", Types: []string{"Country"}},
+		{Node: "37.42#-122.08", Candidate: "geoId/06", Types: []string{"State", "AdministrativeArea1"}},
+		{Node: "37.42#-122.08", Candidate: "geoId/0649670", Types: []string{"City"}},
+		{Node: "37.42#-122.08", Candidate: "geoId/06085", Types: []string{"County"}},
+		{Node: "37.42#-122.08", Candidate: "ipcc_50/37.25_-122.25_USA", Types: []string{"IPCCPlace_50"}},
+	}
+	want := []string{
+		// AdministrativeArea1 takes priority over State.
+		"geoId/06",
+		"geoId/0649670",
+		"geoId/06085",
+		"country/USA",
+		// Other types are last, by dcid.
+		"ipcc_50/37.25_-122.25_USA",
+		"zip/94043",
+	}
+
+	sortCandidatesByPlaceType(candidates)
+	got := []string{}
+	for _, candidate := range candidates {
+		got = append(got, candidate.Candidate)
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+}
+
+func TestNodeEdgesToReachedNodes(t *testing.T) {
+	edges := map[string][]*Edge{
+		"geoId/06": {
+			{SubjectID: "geoId/06", Predicate: "containedInPlace", ObjectID: "country/USA"},
+			// Edges to values don't reach nodes.
+			{SubjectID: "geoId/06", Predicate: "name", ObjectValue: "California"},
+		},
+		"geoId/06085": {
+			{SubjectID: "geoId/06085", Predicate: "containedInPlace", ObjectID: "geoId/06"},
+			{SubjectID: "geoId/06085", Predicate: "containedInPlace", ObjectID: "country/USA"},
+		},
+		"foo": {},
+	}
+	want := map[string][]string{
+		"geoId/06":    {"country/USA"},
+		"geoId/06085": {"geoId/06", "country/USA"},
+	}
+
+	got := nodeEdgesToReachedNodes(edges)
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+}
+
+func TestReachedEdgesToNodeResponse(t *testing.T) {
+	// Both counties are reached from geoId/06, and the state from geoId/06085.
+	reached := map[string][]string{
+		"geoId/06":    {"geoId/06085", "geoId/06001"},
+		"geoId/06085": {"geoId/06"},
+		"foo":         {},
+	}
+	edges := map[string][]*Edge{
+		"geoId/06085": {
+			{SubjectID: "geoId/06085", Predicate: "containedInPlace", ObjectID: "country/USA", Name: "United States", Types: []string{"Country"}},
+			{SubjectID: "geoId/06085", Predicate: "name", ObjectValue: "Santa Clara County", Provenance: "dc/base/WikidataOtherIdGeos"},
+		},
+		"geoId/06001": {
+			{SubjectID: "geoId/06001", Predicate: "containedInPlace", ObjectID: "country/USA", Name: "United States", Types: []string{"Country"}},
+			{SubjectID: "geoId/06001", Predicate: "name", ObjectValue: "Alameda County", Provenance: "dc/base/WikidataOtherIdGeos"},
+		},
+		"geoId/06": {
+			{SubjectID: "geoId/06", Predicate: "containedInPlace", ObjectID: "country/USA", Name: "United States", Types: []string{"Country"}},
+		},
+	}
+	want := &pbv2.NodeResponse{
+		Data: map[string]*pbv2.LinkedGraph{
+			"geoId/06": {
+				Arcs: map[string]*pbv2.Nodes{
+					// Edges reached through both counties are only included once.
+					"containedInPlace": {Nodes: []*pb.EntityInfo{
+						{Dcid: "country/USA", Name: "United States", Types: []string{"Country"}},
+					}},
+					"name": {Nodes: []*pb.EntityInfo{
+						{Value: "Santa Clara County", ProvenanceId: "dc/base/WikidataOtherIdGeos"},
+						{Value: "Alameda County", ProvenanceId: "dc/base/WikidataOtherIdGeos"},
+					}},
+				},
+			},
+			"geoId/06085": {
+				Arcs: map[string]*pbv2.Nodes{
+					"containedInPlace": {Nodes: []*pb.EntityInfo{
+						{Dcid: "country/USA", Name: "United States", Types: []string{"Country"}},
+					}},
+				},
+			},
+			"foo": {Arcs: map[string]*pbv2.Nodes{}},
+		},
+	}
+
+	got := reachedEdgesToNodeResponse(reached, edges)
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+}
+
+func TestReachedPropsToNodeResponse(t *testing.T) {
+	reached := map[string][]string{
+		"Count_Person": {"Person"},
+		"dc/g/Person":  {"Person", "Thing"},
+		"foo":          {},
+	}
+	props := map[string][]*Property{
+		"Person": {
+			{SubjectID: "Person", Predicate: "subClassOf"},
+			{SubjectID: "Person", Predicate: "name"},
+		},
+		"Thing": {
+			{SubjectID: "Thing", Predicate: "typeOf"},
+			{SubjectID: "Thing", Predicate: "name"},
+		},
+	}
+	want := &pbv2.NodeResponse{
+		Data: map[string]*pbv2.LinkedGraph{
+			"Count_Person": {Properties: []string{"name", "subClassOf"}},
+			// Properties of all reached nodes are merged.
+			"dc/g/Person": {Properties: []string{"name", "subClassOf", "typeOf"}},
+			"foo":         {},
+		},
+	}
+
+	got := reachedPropsToNodeResponse(reached, props)
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+}
@@ -57,6 +57,27 @@ func TestNode(t *testing.T) {
 			},
 			goldenFile: "property_values.json",
 		},
+		{
+			req: &pbv2.NodeRequest{
+				Nodes:    []string{"Person"},
+				Property: "->subClassOf->name",
+			},
+			goldenFile: "linked_chain.json",
+		},
+		{
+			req: &pbv2.NodeRequest{
+				Nodes:    []string{"geoId/10"},
+				Property: "<-containedInPlace+{typeOf:County}",
+			},
+			goldenFile: "contained_in_place_chain_filter.json",
+		},
+		{
+			req: &pbv2.NodeRequest{
+				Nodes:    []string{"nuts/UKI1"},
+				Property: "->*{subClassOf:AdministrativeArea, extendedName:AdministrativeArea2}",
+			},
+			goldenFile: "filter.json",
+		},
 	} {
 		got, err := ds.Node(ctx, c.req)
 		if err != nil {
@@ -422,6 +422,75 @@ func TestSearchObjectValues(t *testing.T) {
 
 }
 
+func TestResolve(t *testing.T) {
+	client := test.NewSpannerClient()
+	if client == nil {
+		return
+	}
+
+	t.Parallel()
+	ctx := context.Background()
+	_, filename, _, _ := runtime.Caller(0)
+	goldenDir := path.Join(path.Dir(filename), "query")
+
+	for _, c := range []struct {
+		resolve    func() ([]*spanner.ResolutionCandidate, error)
+		goldenFile string
+	}{
+		{
+			resolve: func() ([]*spanner.ResolutionCandidate, error) {
+				return client.ResolveByID(ctx, []string{"Q30", "Q110739", "foo"}, "wikidataId", "dcid")
+			},
+			goldenFile: "resolve_by_id.json",
+		},
+		{
+			resolve: func() ([]*spanner.ResolutionCandidate, error) {
+				return client.ResolveByID(ctx, []string{"Q30", "Q110739"}, "wikidataId", "name")
+			},
+			goldenFile: "resolve_by_id_to_property.json",
+		},
+		{
+			resolve: func() ([]*spanner.ResolutionCandidate, error) {
+				return client.ResolveByDescription(ctx, []string{"Santa Clara County", "San Mateo County"}, []string{"County"})
+			},
+			goldenFile: "resolve_by_description.json",
+		},
+		{
+			resolve: func() ([]*spanner.ResolutionCandidate, error) {
+				return client.ResolveByCoordinate(ctx, []string{"37.42#-122.08", "+37.42#-122.0800000"}, []string{"County"})
+			},
+			goldenFile: "resolve_by_coordinate.json",
+		},
+	} {
+		actual, err := c.resolve()
+		if err != nil {
+			t.Fatalf("Resolve error (%v): %v", c.goldenFile, err)
+		}
+
+		got, err := test.StructToJSON(actual)
+		if err != nil {
+			t.Fatalf("StructToJSON error (%v): %v", c.goldenFile, err)
+		}
+
+		if test.GenerateGolden {
+			err = test.WriteGolden(got, goldenDir, c.goldenFile)
+			if err != nil {
+				t.Fatalf("WriteGolden error (%v): %v", c.goldenFile, err)
+			}
+			continue
+		}
+
+		want, err := test.ReadGolden(goldenDir, c.goldenFile)
+		if err != nil {
+			t.Fatalf("ReadGolden error (%v): %v", c.goldenFile, err)
+		}
+
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("%v payload mismatch (-want +got):\n%s", c.goldenFile, diff)
+		}
+	}
+}
+
 // simplifySearchNodes simplifies search results for goldens.
 func simplifySearchNodes(results []*spanner.SearchNode) []*spanner.SearchNode {
 	if len(results) > NUM_SEARCH_MATCHES {
@@ -121,6 +121,23 @@ type SearchNode struct {
 	Score              float64  `spanner:"score"`
 }
 
+// ResolutionCandidate struct represents a single row returned for resolve queries.
+type ResolutionCandidate struct {
+	Node      string   `spanner:"node"`
+	Candidate string   `spanner:"candidate"`
+	Types     []string `spanner:"types"`
+}
+
+// EventNode struct represents a single row returned for event queries.
+type EventNode struct {
+	SubjectID string `spanner:"subject_id"`
+}
+
+// EventDate struct represents a single row returned for event date queries.
+type EventDate struct {
+	Date string `spanner:"date"`
+}
+
 // SpannerConfig struct to hold the YAML configuration to a spanner database.
 type SpannerConfig struct {
 	Project  string `yaml:"project"`
@@ -23,17 +23,33 @@ import (
 	"cloud.google.com/go/spanner"
 	"github.com/datacommonsorg/mixer/internal/merger"
 	v2 "github.com/datacommonsorg/mixer/internal/server/v2"
+	"github.com/datacommonsorg/mixer/internal/translator/sparql"
+	"github.com/golang/geo/s2"
 	"google.golang.org/api/iterator"
 )
 
 const (
 	// Maximum number of edge hops to traverse for chained properties.
 	MAX_HOPS = 10
+	// S2 cell level used to resolve coordinates to places.
+	S2_CELL_LEVEL = 10
+	// Out property to resolve nodes to their dcids.
+	DCID_PROP = "dcid"
 )
 
 // Predicates to search against if no predicates are provided.
 var defaultSearchPredicates = []string{"name", "description"}
 
+// Table that Sparql queries are translated against.
+var edgeTable = &sparql.TripleTable{
+	Name: "Edge",
+	Object: func(alias string) string {
+		return fmt.Sprintf("COALESCE(%[1]s.object_value, %[1]s.object_id)", alias)
+	},
+	Param:     func(name string) string { return "@" + name },
+	ListParam: func(name string) string { return "UNNEST(@" + name + ")" },
+}
+
 // GetNodeProps retrieves node properties from Spanner given a list of IDs and a direction and returns a map.
 func (sc *SpannerClient) GetNodeProps(ctx context.Context, ids []string, out bool) (map[string][]*Property, error) {
 	props := map[string][]*Property{}
@@ -319,6 +335,219 @@ func (sc *SpannerClient) SearchObjectValues(ctx context.Context, query string, p
 	return nodes, nil
 }
 
+// ResolveByID resolves nodes to values of the out property of nodes with matching in property values.
+// If the out property is dcid, the nodes are resolved to the dcids of the matching nodes.
+func (sc *SpannerClient) ResolveByID(ctx context.Context, nodes []string, inProp, outProp string) ([]*ResolutionCandidate, error) {
+	if len(nodes) == 0 {
+		return []*ResolutionCandidate{}, nil
+	}
+
+	stmt := spanner.Statement{
+		SQL: statements.resolveByID,
+		Params: map[string]interface{}{
+			"nodes":  nodes,
+			"inProp": inProp,
+		},
+	}
+	if outProp != DCID_PROP {
+		stmt.SQL = statements.resolveByIDToProperty
+		stmt.Params["outProp"] = outProp
+	}
+
+	return sc.collectResolutionCandidates(ctx, stmt)
+}
+
+// ResolveByDescription resolves descriptions to the dcids of nodes with matching names and optionally the types.
+func (sc *SpannerClient) ResolveByDescription(ctx context.Context, nodes []string, types []string) ([]*ResolutionCandidate, error) {
+	if len(nodes) == 0 {
+		return []*ResolutionCandidate{}, nil
+	}
+
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(statements.resolveByDescription, ""),
+		Params: map[string]interface{}{
+			"nodes": nodes,
+		},
+	}
+	if len(types) > 0 {
+		stmt.SQL = fmt.Sprintf(statements.resolveByDescription, statements.filterTypes)
+		stmt.Params["types"] = types
+	}
+
+	return sc.collectResolutionCandidates(ctx, stmt)
+}
+
+// ResolveByCoordinate resolves coordinates of the form "<lat>#<lng>" to the dcids of places containing them and optionally the types.
+// Places are found through the S2 cell (at S2_CELL_LEVEL) that contains the coordinate.
+func (sc *SpannerClient) ResolveByCoordinate(ctx context.Context, nodes []string, types []string) ([]*ResolutionCandidate, error) {
+	if len(nodes) == 0 {
+		return []*ResolutionCandidate{}, nil
+	}
+
+	// Map of S2 cell dcid to the coordinates in the cell.
+	cellToNodes := map[string][]string{}
+	cells := []string{}
+	for _, node := range nodes {
+		lat, lng, err := v2.ParseCoordinate(node)
+		if err != nil {
+			return nil, err
+		}
+		cellID := s2.CellIDFromLatLng(s2.LatLngFromDegrees(lat, lng)).Parent(S2_CELL_LEVEL)
+		cell := fmt.Sprintf("s2CellId/0x%016x", uint64(cellID))
+		if _, ok := cellToNodes[cell]; !ok {
+			cells = append(cells, cell)
+		}
+		cellToNodes[cell] = append(cellToNodes[cell], node)
+	}
+
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(statements.resolveByS2Cell, ""),
+		Params: map[string]interface{}{
+			"nodes": cells,
+		},
+	}
+	if len(types) > 0 {
+		stmt.SQL = fmt.Sprintf(statements.resolveByS2Cell, statements.filterTypes)
+		stmt.Params["types"] = types
+	}
+
+	cellCandidates, err := sc.collectResolutionCandidates(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []*ResolutionCandidate{}
+	for _, cellCandidate := range cellCandidates {
+		for _, node := range cellToNodes[cellCandidate.Node] {
+			candidates = append(candidates, &ResolutionCandidate{
+				Node:      node,
+				Candidate: cellCandidate.Candidate,
+				Types:     cellCandidate.Types,
+			})
+		}
+	}
+	return candidates, nil
+}
+
+// GetEventEdges retrieves the out edges of events of a type that affected a place in a month (YYYY-MM).
+func (sc *SpannerClient) GetEventEdges(ctx context.Context, eventType, place, date string) ([]string, map[string][]*Edge, error) {
+	stmt := spanner.Statement{
+		SQL: statements.getEventsByTypePlaceAndDate,
+		Params: map[string]interface{}{
+			"eventType": eventType,
+			"place":     place,
+			"date":      date,
+		},
+	}
+
+	events := []string{}
+	err := sc.queryAndCollect(
+		ctx,
+		stmt,
+		func() interface{} {
+			return &EventNode{}
+		},
+		func(rowStruct interface{}) {
+			event := rowStruct.(*EventNode)
+			events = append(events, event.SubjectID)
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	edges, err := sc.GetNodeEdgesByID(ctx, events, &v2.Arc{Out: true, SingleProp: WILDCARD})
+	if err != nil {
+		return nil, nil, err
+	}
+	return events, edges, nil
+}
+
+// GetEventDates retrieves the months (YYYY-MM) with events of a type that affected a place.
+func (sc *SpannerClient) GetEventDates(ctx context.Context, eventType, place string) ([]string, error) {
+	stmt := spanner.Statement{
+		SQL: statements.getEventDatesByTypeAndPlace,
+		Params: map[string]interface{}{
+			"eventType": eventType,
+			"place":     place,
+		},
+	}
+
+	dates := []string{}
+	err := sc.queryAndCollect(
+		ctx,
+		stmt,
+		func() interface{} {
+			return &EventDate{}
+		},
+		func(rowStruct interface{}) {
+			eventDate := rowStruct.(*EventDate)
+			dates = append(dates, eventDate.Date)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return dates, nil
+}
+
+// QueryTriples runs a Sparql query against the Edge table.
+// It returns the selected node aliases and the values of each matching row.
+func (sc *SpannerClient) QueryTriples(ctx context.Context, query string) ([]string, [][]string, error) {
+	translation, err := sparql.TranslateToTriples(query, edgeTable)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stmt := spanner.Statement{
+		SQL:    translation.SQL,
+		Params: translation.Params,
+	}
+	iter := sc.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	rows := [][]string{}
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch row: %w", err)
+		}
+
+		values := make([]string, row.Size())
+		for i := range values {
+			var cell spanner.NullString
+			if err := row.Column(i, &cell); err != nil {
+				return nil, nil, fmt.Errorf("failed to parse row: %w", err)
+			}
+			values[i] = cell.StringVal
+		}
+		rows = append(rows, values)
+	}
+	return translation.Header, rows, nil
+}
+
+func (sc *SpannerClient) collectResolutionCandidates(ctx context.Context, stmt spanner.Statement) ([]*ResolutionCandidate, error) {
+	candidates := []*ResolutionCandidate{}
+	err := sc.queryAndCollect(
+		ctx,
+		stmt,
+		func() interface{} {
+			return &ResolutionCandidate{}
+		},
+		func(rowStruct interface{}) {
+			candidate := rowStruct.(*ResolutionCandidate)
+			candidates = append(candidates, candidate)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return candidates, nil
+}
+
 func (sc *SpannerClient) queryAndCollect(
 	ctx context.Context,
 	stmt spanner.Statement,
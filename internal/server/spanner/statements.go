@@ -51,6 +51,18 @@ var statements = struct {
 	searchObjectValues string
 	// Subquery to filter search results by types.
 	filterTypes string
+	// Resolve nodes to dcids by in-property values.
+	resolveByID string
+	// Resolve nodes to out-property values by in-property values.
+	resolveByIDToProperty string
+	// Resolve descriptions to dcids by node names.
+	resolveByDescription string
+	// Resolve S2 cells to dcids of places containing them.
+	resolveByS2Cell string
+	// Fetch events by type, affected place and month.
+	getEventsByTypePlaceAndDate string
+	// Fetch event months by type and affected place.
+	getEventDatesByTypeAndPlace string
 }{
 	getPropsBySubjectID: `
 		GRAPH DCGraph MATCH -[e:Edge
@@ -303,4 +315,88 @@ var statements = struct {
 		LIMIT %d
 	`,
 	filterTypes: `WHERE ARRAY_INCLUDES_ANY(n.types, @types)`,
+	resolveByID: `
+		GRAPH DCGraph MATCH (n:Node)-[e:Edge
+		WHERE
+			e.predicate = @inProp
+			AND (
+				e.object_value IN UNNEST(@nodes)
+				OR e.object_id IN UNNEST(@nodes)
+			)]->
+		RETURN DISTINCT
+			COALESCE(e.object_value, e.object_id) AS node,
+			n.subject_id AS candidate,
+			COALESCE(n.types, []) AS types
+		ORDER BY
+			node,
+			candidate
+	`,
+	resolveByIDToProperty: `
+		GRAPH DCGraph MATCH (n:Node)-[e:Edge
+		WHERE
+			e.predicate = @inProp
+			AND (
+				e.object_value IN UNNEST(@nodes)
+				OR e.object_id IN UNNEST(@nodes)
+			)]->,
+		(n)-[o:Edge
+		WHERE
+			o.predicate = @outProp]->
+		RETURN DISTINCT
+			COALESCE(e.object_value, e.object_id) AS node,
+			COALESCE(o.object_value, o.object_id) AS candidate,
+			ARRAY<STRING>[] AS types
+		ORDER BY
+			node,
+			candidate
+	`,
+	resolveByDescription: `
+		GRAPH DCGraph MATCH (n:Node %s)
+		WHERE
+			n.name IN UNNEST(@nodes)
+		RETURN DISTINCT
+			n.name AS node,
+			n.subject_id AS candidate,
+			COALESCE(n.types, []) AS types
+		ORDER BY
+			node,
+			candidate
+	`,
+	resolveByS2Cell: `
+		GRAPH DCGraph MATCH -[e:Edge
+		WHERE
+			e.subject_id IN UNNEST(@nodes)
+			AND e.predicate = 'linkedContainedInPlace']->(n:Node %s)
+		RETURN DISTINCT
+			e.subject_id AS node,
+			n.subject_id AS candidate,
+			COALESCE(n.types, []) AS types
+		ORDER BY
+			node,
+			candidate
+	`,
+	getEventsByTypePlaceAndDate: `
+		GRAPH DCGraph MATCH (e:Node)-[{predicate: 'typeOf', object_id: @eventType}]->,
+		(e)-[{predicate: 'affectedPlace', object_id: @place}]->,
+		(e)-[d:Edge
+		WHERE
+			d.predicate = 'startDate'
+			AND STARTS_WITH(d.object_value, @date)]->
+		RETURN DISTINCT
+			e.subject_id
+		ORDER BY
+			e.subject_id
+	`,
+	getEventDatesByTypeAndPlace: `
+		GRAPH DCGraph MATCH (e:Node)-[{predicate: 'typeOf', object_id: @eventType}]->,
+		(e)-[{predicate: 'affectedPlace', object_id: @place}]->,
+		(e)-[d:Edge
+		WHERE
+			d.predicate = 'startDate'
+			AND d.object_value IS NOT NULL]->
+		RETURN DISTINCT
+			SUBSTR(d.object_value, 1, 7) AS date
+		ORDER BY
+			date
+	`,
 }
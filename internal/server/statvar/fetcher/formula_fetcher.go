@@ -18,6 +18,7 @@ import (
 	"context"
 	"net/http"
 	"sort"
+	"time"
 
 	"github.com/datacommonsorg/mixer/internal/merger"
 	pbv1 "github.com/datacommonsorg/mixer/internal/proto/v1"
@@ -36,6 +37,8 @@ const (
 	StatisticalCalculation  = "StatisticalCalculation"
 	typeOf                  = "typeOf"
 	v2node                  = "/v2/node"
+	// Timeout of each request to the remote mixer.
+	remoteFetchTimeout = 30 * time.Second
 )
 
 // FetchFormulas fetches StatisticalCalculations and returns a map of SV dcids to a list of inputPropertyExpressions.
@@ -121,6 +124,7 @@ func FetchFormulas(
 	// Fetch for Remote Mixer.
 	if metadata.RemoteMixerDomain != "" {
 		errGroup.Go(func() error {
+			httpClient := &http.Client{Timeout: remoteFetchTimeout}
 			remoteResp := &pbv2.NodeResponse{}
 			statCalDcids := []string{}
 			nextToken := ""
@@ -131,7 +135,7 @@ func FetchFormulas(
 					NextToken: nextToken,
 				}
 				statCalResp := &pbv2.NodeResponse{}
-				err := util.FetchRemote(metadata, &http.Client{}, v2node, statCalReq, statCalResp)
+				err := util.FetchRemoteWithContext(errCtx, metadata, httpClient, v2node, statCalReq, statCalResp)
 				if err != nil {
 					return err
 				}
@@ -153,7 +157,7 @@ func FetchFormulas(
 					Property:  "->[" + outputProperty + ", " + inputPropertyExpression + "]",
 					NextToken: nextToken,
 				}
-				err := util.FetchRemote(metadata, &http.Client{}, v2node, propReq, currResp)
+				err := util.FetchRemoteWithContext(errCtx, metadata, httpClient, v2node, propReq, currResp)
 				if err != nil {
 					return err
 				}
@@ -18,7 +18,10 @@ import (
 	"fmt"
 	"go/ast"
 	"go/parser"
-	"reflect"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"sort"
 	"strings"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
@@ -39,6 +42,8 @@ type VariableFormula struct {
 	LeafData map[string]*ASTNode
 	// List of distinct StatVars in the formula.
 	StatVars []string
+	// Whether the formula uses the observations of other dates, e.g. with lag(x, P1Y).
+	HasTimeFunctions bool
 }
 
 var (
@@ -53,13 +58,34 @@ var (
 )
 
 func encodeForParse(s string) string {
-	res := s
-	for k, v := range encodeForParseTokenMap {
-		res = strings.ReplaceAll(res, k, v)
-	}
+	res, _ := encodeForParseWithOffsets(s)
 	return res
 }
 
+// encodeForParseWithOffsets encodes s like encodeForParse and also returns the offset in s
+// of each byte of the encoded string, followed by len(s), to report errors at positions in s.
+func encodeForParseWithOffsets(s string) (string, []int) {
+	var res strings.Builder
+	offsets := make([]int, 0, len(s)+1)
+	for i := 0; i < len(s); {
+		key, encoded := "", ""
+		for k, v := range encodeForParseTokenMap {
+			if strings.HasPrefix(s[i:], k) && len(k) > len(key) {
+				key, encoded = k, v
+			}
+		}
+		if key == "" {
+			key, encoded = s[i:i+1], s[i:i+1]
+		}
+		res.WriteString(encoded)
+		for j := 0; j < len(encoded); j++ {
+			offsets = append(offsets, i)
+		}
+		i += len(key)
+	}
+	return res.String(), append(offsets, len(s))
+}
+
 func decodeForParse(s string) string {
 	res := s
 	for k, v := range encodeForParseTokenMap {
@@ -84,6 +110,9 @@ func parseNode(nodeString string) (*ASTNode, error) {
 		res.Facet = &pb.Facet{}
 		filterString := nodeString[leftBracketIndex+1 : len(nodeString)-1]
 		for _, filter := range strings.Split(filterString, ";") {
+			if len(filter) < 3 || filter[2] != '=' {
+				return nil, fmt.Errorf("invalid filter %q, want a filter like mm=<value>", filter)
+			}
 			filterType := filter[0:2]
 			filterVal := filter[3:]
 			switch filterType {
@@ -109,43 +138,140 @@ func parseNode(nodeString string) (*ASTNode, error) {
 	return res, nil
 }
 
+// ParseError is an error in a formula, at the position of the offending token.
+type ParseError struct {
+	// Position of the offending token in the formula, starting at 1.
+	Pos int
+	Msg string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid formula at position %d: %s", e.Pos, e.Msg)
+}
+
+// formulaParser fills a VariableFormula from its AST tree.
+type formulaParser struct {
+	fset *token.FileSet
+	// Offsets in the formula of the bytes of the parsed formula, see encodeForParseWithOffsets.
+	offsets []int
+	formula *VariableFormula
+}
+
+// position returns the position in the formula of an offset in the parsed formula.
+func (p *formulaParser) position(offset int) int {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(p.offsets) {
+		offset = len(p.offsets) - 1
+	}
+	return p.offsets[offset] + 1
+}
+
+func (p *formulaParser) errorf(pos token.Pos, format string, args ...interface{}) error {
+	return &ParseError{Pos: p.position(p.fset.Position(pos).Offset), Msg: fmt.Sprintf(format, args...)}
+}
+
 // Recursively iterate through the AST tree, extract and parse nodeString, then fill nodeData.
-func processNodeInfo(node ast.Node, c *VariableFormula) error {
+func (p *formulaParser) processNodeInfo(node ast.Expr) error {
 	switch t := node.(type) {
-	case *ast.BinaryExpr:
-		for _, node := range []ast.Node{t.X, t.Y} {
-			if reflect.TypeOf(node).String() == "*ast.Ident" {
-				nodeString := node.(*ast.Ident).Name
-				nodeData, err := parseNode(decodeForParse(nodeString))
-				if err != nil {
-					return err
-				}
-				c.LeafData[nodeString] = nodeData
-			} else {
-				if err := processNodeInfo(node, c); err != nil {
-					return err
-				}
-			}
+	case *ast.Ident:
+		nodeData, err := parseNode(decodeForParse(t.Name))
+		if err != nil {
+			return p.errorf(t.Pos(), "%s", err)
 		}
-	case *ast.ParenExpr:
-		return processNodeInfo(t.X, c)
+		p.formula.LeafData[t.Name] = nodeData
 	case *ast.BasicLit:
 		// Handle constants when evaluating formula.
+		if t.Kind != token.INT && t.Kind != token.FLOAT {
+			return p.errorf(t.Pos(), "unsupported constant %s", t.Value)
+		}
+	case *ast.ParenExpr:
+		return p.processNodeInfo(t.X)
+	case *ast.UnaryExpr:
+		if t.Op != token.ADD && t.Op != token.SUB {
+			return p.errorf(t.OpPos, "unsupported operator %s", t.Op)
+		}
+		return p.processNodeInfo(t.X)
+	case *ast.BinaryExpr:
+		switch t.Op {
+		case token.ADD, token.SUB, token.MUL, token.QUO:
+		default:
+			return p.errorf(t.OpPos, "unsupported operator %s", t.Op)
+		}
+		for _, node := range []ast.Expr{t.X, t.Y} {
+			if err := p.processNodeInfo(node); err != nil {
+				return err
+			}
+		}
+	case *ast.CallExpr:
+		return p.processCall(t)
 	default:
-		return fmt.Errorf("unsupported AST type %T", t)
+		return p.errorf(node.Pos(), "unsupported expression %s", decodeForParse(types.ExprString(node)))
 	}
 
 	return nil
 }
 
+// processCall checks the function and the arguments of a call, then fills the nodeData of the arguments.
+func (p *formulaParser) processCall(call *ast.CallExpr) error {
+	name, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return p.errorf(call.Fun.Pos(), "unsupported function %s", decodeForParse(types.ExprString(call.Fun)))
+	}
+	function, ok := functions[name.Name]
+	if !ok {
+		return p.errorf(name.Pos(), "unknown function %s", decodeForParse(name.Name))
+	}
+	if call.Ellipsis.IsValid() ||
+		len(call.Args) < function.minArgs || (function.maxArgs >= 0 && len(call.Args) > function.maxArgs) {
+		return p.errorf(call.Lparen, "wrong number of arguments, want %s", function.usage)
+	}
+	args := call.Args
+	if function.periodArg {
+		period := args[len(args)-1]
+		if _, err := ParsePeriod(types.ExprString(period)); err != nil {
+			return p.errorf(period.Pos(), "invalid period %s, want an ISO 8601 period like P1Y",
+				decodeForParse(types.ExprString(period)))
+		}
+		args = args[:len(args)-1]
+		p.formula.HasTimeFunctions = true
+	}
+	for _, arg := range args {
+		if err := p.processNodeInfo(arg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewVariableFormula parses a formula of variables with optional facet filters, constants, the operators
+// + - * / and the functions:
+//   - abs(x) and log(x), the natural logarithm.
+//   - min(x, y, ...), max(x, y, ...) and sum(x, ...).
+//   - coalesce(x, y, ...), the value of the first argument with a value.
+//   - lag(x, period), the value of x a period before, e.g. lag(Count_Person, P1Y).
+//   - pct_change(x, period), the change of x since a period before as a fraction of the value then.
+//
+// Errors in the formula are returned as a *ParseError.
 func NewVariableFormula(formula string) (*VariableFormula, error) {
-	expr, err := parser.ParseExpr(encodeForParse(formula))
+	encoded, offsets := encodeForParseWithOffsets(formula)
+	p := &formulaParser{
+		fset:    token.NewFileSet(),
+		offsets: offsets,
+		formula: &VariableFormula{LeafData: map[string]*ASTNode{}},
+	}
+	expr, err := parser.ParseExprFrom(p.fset, "", encoded, 0)
 	if err != nil {
+		if list, ok := err.(scanner.ErrorList); ok && len(list) > 0 {
+			return nil, &ParseError{Pos: p.position(list[0].Pos.Offset), Msg: decodeForParse(list[0].Msg)}
+		}
 		return nil, err
 	}
 
-	c := &VariableFormula{Expr: expr, LeafData: map[string]*ASTNode{}}
-	if err := processNodeInfo(expr, c); err != nil {
+	c := p.formula
+	c.Expr = expr
+	if err := p.processNodeInfo(expr); err != nil {
 		return nil, err
 	}
 
@@ -157,6 +283,7 @@ func NewVariableFormula(formula string) (*VariableFormula, error) {
 	for k := range statVarSet {
 		statVars = append(statVars, k)
 	}
+	sort.Strings(statVars)
 	c.StatVars = statVars
 
 	return c, nil
@@ -79,6 +79,18 @@ func TestVariableFormulaParseFormula(t *testing.T) {
 			"(Count_Person * 2.5) + 1",
 			[]string{"Count_Person"},
 		},
+		{
+			"sum(Count_Person_Female[mm=dcAggregate/Census], Count_Person_Male, 1) / max(Count_Person, 1)",
+			[]string{"Count_Person", "Count_Person_Female", "Count_Person_Male"},
+		},
+		{
+			"pct_change(coalesce(Count_Person[op=P1Y], Count_Person), P1Y) * 100",
+			[]string{"Count_Person"},
+		},
+		{
+			"-log(abs(min(Amount_Debt, Amount_Debt_Government)))",
+			[]string{"Amount_Debt", "Amount_Debt_Government"},
+		},
 	} {
 		vf, err := NewVariableFormula(c.formula)
 		if err != nil {
@@ -90,3 +102,56 @@ func TestVariableFormulaParseFormula(t *testing.T) {
 		}
 	}
 }
+
+func TestNewVariableFormulaErrors(t *testing.T) {
+	for _, c := range []struct {
+		formula string
+		wantPos int
+	}{
+		{"Count_Person +", 15},
+		{"Count_Person[mm=Census] * (Count_Farm", 38},
+		{"Count_Person % 2", 14},
+		{"median(Count_Person, Count_Farm)", 1},
+		{"abs(Count_Person, Count_Farm)", 4},
+		{"min(Count_Person)", 4},
+		{"lag(Count_Person[op=P1Y], P1X)", 27},
+		{"lag(Count_Person, P0Y)", 19},
+		{"Count_Person[mm=Census;xx=1] + 1", 1},
+		{"Count_Person.Female", 1},
+	} {
+		_, err := NewVariableFormula(c.formula)
+		parseErr, ok := err.(*ParseError)
+		if !ok {
+			t.Errorf("NewVariableFormula(%s) error = %v, want a ParseError", c.formula, err)
+			continue
+		}
+		if parseErr.Pos != c.wantPos {
+			t.Errorf("NewVariableFormula(%s) error position = %d, want %d (%v)", c.formula, parseErr.Pos, c.wantPos, err)
+		}
+	}
+}
+
+func TestPeriodBefore(t *testing.T) {
+	for _, c := range []struct {
+		period string
+		date   string
+		want   string
+	}{
+		{"P1Y", "2020", "2019"},
+		{"P1Y", "2020-06", "2019-06"},
+		{"P1Y6M", "2020-03-15", "2018-09-15"},
+		{"P3M", "2020-02", "2019-11"},
+		{"P3M", "2020", ""},
+		{"P1D", "2020-06", ""},
+		{"P1Y", "2020Q1", ""},
+	} {
+		period, err := ParsePeriod(c.period)
+		if err != nil {
+			t.Fatalf("ParsePeriod(%s) = %s", c.period, err)
+		}
+		got, ok := period.Before(c.date)
+		if ok != (c.want != "") || got != c.want {
+			t.Errorf("ParsePeriod(%s).Before(%s) = %s, %t, want %s", c.period, c.date, got, ok, c.want)
+		}
+	}
+}
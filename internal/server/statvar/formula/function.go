@@ -0,0 +1,112 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formula
+
+import (
+	"fmt"
+	"go/ast"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Names of the functions of formulas.
+const (
+	FuncAbs       = "abs"
+	FuncLog       = "log"
+	FuncMin       = "min"
+	FuncMax       = "max"
+	FuncSum       = "sum"
+	FuncCoalesce  = "coalesce"
+	FuncLag       = "lag"
+	FuncPctChange = "pct_change"
+)
+
+type function struct {
+	// Number of arguments, maxArgs is -1 for any number.
+	minArgs, maxArgs int
+	// Whether the last argument is a period, e.g. P1Y.
+	periodArg bool
+	usage     string
+}
+
+var functions = map[string]function{
+	FuncAbs:       {minArgs: 1, maxArgs: 1, usage: "abs(x)"},
+	FuncLog:       {minArgs: 1, maxArgs: 1, usage: "log(x)"},
+	FuncMin:       {minArgs: 2, maxArgs: -1, usage: "min(x, y, ...)"},
+	FuncMax:       {minArgs: 2, maxArgs: -1, usage: "max(x, y, ...)"},
+	FuncSum:       {minArgs: 1, maxArgs: -1, usage: "sum(x, ...)"},
+	FuncCoalesce:  {minArgs: 2, maxArgs: -1, usage: "coalesce(x, y, ...)"},
+	FuncLag:       {minArgs: 2, maxArgs: 2, periodArg: true, usage: "lag(x, period)"},
+	FuncPctChange: {minArgs: 2, maxArgs: 2, periodArg: true, usage: "pct_change(x, period)"},
+}
+
+// HasPeriodArg returns whether the last argument of a function call in a formula is a period, e.g. lag(x, P1Y).
+func HasPeriodArg(call *ast.CallExpr) bool {
+	name, ok := call.Fun.(*ast.Ident)
+	return ok && functions[name.Name].periodArg
+}
+
+// Period is an ISO 8601 period of years, months and days, e.g. P1Y or P6M.
+type Period struct {
+	Years, Months, Days int
+}
+
+var periodRegex = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)D)?$`)
+
+// ParsePeriod parses an ISO 8601 period of years, months and days, e.g. P1Y or P1Y6M.
+func ParsePeriod(s string) (*Period, error) {
+	match := periodRegex.FindStringSubmatch(s)
+	if match == nil {
+		return nil, fmt.Errorf("invalid period %s", s)
+	}
+	var components [3]int
+	for i, m := range match[1:] {
+		if m == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m)
+		if err != nil {
+			return nil, fmt.Errorf("invalid period %s", s)
+		}
+		components[i] = n
+	}
+	period := &Period{Years: components[0], Months: components[1], Days: components[2]}
+	if *period == (Period{}) {
+		return nil, fmt.Errorf("invalid period %s", s)
+	}
+	return period, nil
+}
+
+// Before returns the date a period before a date, in the same format of "2006", "2006-01" or "2006-01-02".
+// It returns false for other formats, and for periods finer than the date, e.g. P6M before a year.
+func (p *Period) Before(date string) (string, bool) {
+	var layout string
+	switch {
+	case len(date) == len("2006") && p.Months == 0 && p.Days == 0:
+		layout = "2006"
+	case len(date) == len("2006-01") && p.Days == 0:
+		layout = "2006-01"
+	case len(date) == len("2006-01-02"):
+		layout = "2006-01-02"
+	default:
+		return "", false
+	}
+	t, err := time.Parse(layout, date)
+	if err != nil {
+		return "", false
+	}
+	return t.AddDate(-p.Years, -p.Months, -p.Days).Format(layout), true
+}
@@ -17,14 +17,11 @@ package event
 
 import (
 	"context"
-	"strconv"
-	"strings"
 
 	pbv1 "github.com/datacommonsorg/mixer/internal/proto/v1"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
 	v1e "github.com/datacommonsorg/mixer/internal/server/v1/event"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	v2 "github.com/datacommonsorg/mixer/internal/server/v2"
 
 	"github.com/datacommonsorg/mixer/internal/store"
 )
@@ -82,31 +79,14 @@ func EventCollectionDate(
 // ParseEventCollectionFilter parses filter for EventCollection.
 // Example: property = "area", filterExpr = "3.1#6.2#Acre".
 func ParseEventCollectionFilter(property, filterExpr string) (*v1e.FilterSpec, error) {
-	if property == "" || filterExpr == "" {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"invalid event filter: %s, %s", property, filterExpr)
-	}
-
-	parts := strings.Split(filterExpr, "#")
-	if len(parts) != 3 {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"invalid event filter: %s", filterExpr)
-	}
-
-	var err error
-	res := &v1e.FilterSpec{Prop: property, Unit: parts[2]}
-
-	res.LowerLimit, err = strconv.ParseFloat(parts[0], 64)
+	filter, err := v2.ParseEventFilter(property, filterExpr)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"invalid event filter lower limit: %s", filterExpr)
-	}
-
-	res.UpperLimit, err = strconv.ParseFloat(parts[1], 64)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"invalid event filter upper limit: %s", filterExpr)
+		return nil, err
 	}
-
-	return res, nil
+	return &v1e.FilterSpec{
+		Prop:       filter.Prop,
+		Unit:       filter.Unit,
+		LowerLimit: filter.LowerLimit,
+		UpperLimit: filter.UpperLimit,
+	}, nil
 }
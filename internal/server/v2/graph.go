@@ -15,13 +15,37 @@
 // Package v2 is the version 2 of the Data Commons REST API.
 package v2
 
-import "github.com/datacommonsorg/mixer/internal/util"
+import (
+	"sort"
+	"strings"
+
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
 
 const (
 	// Indicates that all properties should be returned.
 	WILDCARD = "*"
 )
 
+// Place types in order of priority when picking the dominant type of a resolved place.
+var ResolvedPlaceTypePriorityList = []string{
+	"AdministrativeArea5",
+	"AdministrativeArea4",
+	"AdministrativeArea3",
+	"AdministrativeArea2",
+	"AdministrativeArea1",
+	"EurostatNUTS3",
+	"EurostatNUTS2",
+	"EurostatNUTS1",
+	"Town",
+	"City",
+	"County",
+	"State",
+	"Country",
+}
+
 // Arc represents an arc in the graph.
 type Arc struct {
 	// Whether it's out or in arc.
@@ -60,8 +84,117 @@ func (arc *Arc) IsPropertyValuesArc() (bool, []string) {
 	}
 }
 
+// String returns the expression of the arc, e.g. <-containedInPlace+{typeOf:City}.
+// Filter properties are sorted so that equal arcs have the same expression.
+func (arc *Arc) String() string {
+	var sb strings.Builder
+	if arc.Out {
+		sb.WriteString("->")
+	} else {
+		sb.WriteString("<-")
+	}
+	if len(arc.BracketProps) > 0 {
+		sb.WriteString("[" + strings.Join(arc.BracketProps, ",") + "]")
+	} else {
+		sb.WriteString(arc.SingleProp + arc.Decorator)
+	}
+	if len(arc.Filter) > 0 {
+		props := make([]string, 0, len(arc.Filter))
+		for prop := range arc.Filter {
+			props = append(props, prop)
+		}
+		sort.Strings(props)
+		filters := make([]string, 0, len(props))
+		for _, prop := range props {
+			values := arc.Filter[prop]
+			if len(values) == 1 {
+				filters = append(filters, prop+":"+values[0])
+			} else {
+				filters = append(filters, prop+":["+strings.Join(values, ",")+"]")
+			}
+		}
+		sb.WriteString("{" + strings.Join(filters, ",") + "}")
+	}
+	return sb.String()
+}
+
 // LinkedNodes represents a local graph starting from a node with connected arcs.
 type LinkedNodes struct {
 	Subject string
 	Arcs    []*Arc
 }
+
+// GetDominantType returns the dominant type of a node given all its types.
+// The type with the highest priority in ResolvedPlaceTypePriorityList is picked,
+// otherwise the alphabetically first type is picked.
+func GetDominantType(types []string) string {
+	typeSet := map[string]struct{}{}
+	for _, t := range types {
+		typeSet[t] = struct{}{}
+	}
+	for _, priorityType := range ResolvedPlaceTypePriorityList {
+		if _, ok := typeSet[priorityType]; ok {
+			return priorityType
+		}
+	}
+	if len(types) == 0 {
+		return ""
+	}
+	sorted := append([]string{}, types...)
+	sort.Strings(sorted)
+	return sorted[0]
+}
+
+// FollowArcs follows all but the last arc of a chain of arcs from the nodes, one arc at a time.
+// It returns the nodes reached from each node, from which the last arc is to be followed.
+// hop returns the nodes reached by one arc from each of the given nodes.
+func FollowArcs(
+	nodes []string,
+	arcs []*Arc,
+	hop func(nodes []string, arc *Arc) (map[string][]string, error),
+) (map[string][]string, error) {
+	reached := map[string][]string{}
+	for _, node := range nodes {
+		reached[node] = []string{node}
+	}
+	for i := 0; i < len(arcs)-1; i++ {
+		if arcs[i].IsNodePropertiesArc() {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"only the last arc of a chain can get the properties of nodes")
+		}
+		hopNodes, err := hop(ReachedNodes(reached), arcs[i])
+		if err != nil {
+			return nil, err
+		}
+		for node, current := range reached {
+			next := []string{}
+			seen := map[string]struct{}{}
+			for _, c := range current {
+				for _, n := range hopNodes[c] {
+					if _, ok := seen[n]; !ok {
+						seen[n] = struct{}{}
+						next = append(next, n)
+					}
+				}
+			}
+			reached[node] = next
+		}
+	}
+	return reached, nil
+}
+
+// ReachedNodes returns all nodes reached by FollowArcs, sorted and without duplicates.
+func ReachedNodes(reached map[string][]string) []string {
+	nodeSet := map[string]struct{}{}
+	for _, nodes := range reached {
+		for _, n := range nodes {
+			nodeSet[n] = struct{}{}
+		}
+	}
+	result := []string{}
+	for n := range nodeSet {
+		result = append(result, n)
+	}
+	sort.Strings(result)
+	return result
+}
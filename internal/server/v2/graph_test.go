@@ -0,0 +1,103 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFollowArcs(t *testing.T) {
+	// Objects of each property, keyed by subject.
+	graph := map[string]map[string][]string{
+		"typeOf": {
+			"geoId/06":    {"State", "AdministrativeArea1"},
+			"geoId/06085": {"County"},
+			"geoId/06001": {"County"},
+		},
+		"subClassOf": {
+			"State":               {"AdministrativeArea1", "Place"},
+			"County":              {"AdministrativeArea2", "Place"},
+			"AdministrativeArea1": {"Place"},
+		},
+	}
+	hop := func(nodes []string, arc *Arc) (map[string][]string, error) {
+		result := map[string][]string{}
+		for _, node := range nodes {
+			if objects, ok := graph[arc.SingleProp][node]; ok {
+				result[node] = objects
+			}
+		}
+		return result, nil
+	}
+
+	for _, c := range []struct {
+		nodes       []string
+		property    string
+		want        map[string][]string
+		wantReached []string
+	}{
+		{
+			// The last arc isn't followed.
+			[]string{"geoId/06"},
+			"->typeOf",
+			map[string][]string{"geoId/06": {"geoId/06"}},
+			[]string{"geoId/06"},
+		},
+		{
+			// Nodes reached more than once from a node are only kept once.
+			[]string{"geoId/06", "geoId/06085", "foo"},
+			"->typeOf->subClassOf->name",
+			map[string][]string{
+				"geoId/06":    {"AdministrativeArea1", "Place"},
+				"geoId/06085": {"AdministrativeArea2", "Place"},
+				"foo":         {},
+			},
+			[]string{"AdministrativeArea1", "AdministrativeArea2", "Place"},
+		},
+		{
+			[]string{"geoId/06085", "geoId/06001"},
+			"->typeOf->name",
+			map[string][]string{
+				"geoId/06085": {"County"},
+				"geoId/06001": {"County"},
+			},
+			[]string{"County"},
+		},
+	} {
+		arcs, err := ParseProperty(c.property)
+		if err != nil {
+			t.Fatalf("ParseProperty(%s) = %s", c.property, err)
+		}
+		got, err := FollowArcs(c.nodes, arcs, hop)
+		if err != nil {
+			t.Errorf("FollowArcs(%s) = %s", c.property, err)
+			continue
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("FollowArcs(%s) got diff %v", c.property, diff)
+		}
+		if diff := cmp.Diff(ReachedNodes(got), c.wantReached); diff != "" {
+			t.Errorf("ReachedNodes(%s) got diff %v", c.property, diff)
+		}
+	}
+
+	// Only the last arc can get the properties of nodes.
+	arcs := []*Arc{{Out: true}, {Out: true, SingleProp: "name"}}
+	if _, err := FollowArcs([]string{"geoId/06"}, arcs, hop); err == nil {
+		t.Errorf("FollowArcs(%v) got no error, want error", arcs)
+	}
+}
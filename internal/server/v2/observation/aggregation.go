@@ -0,0 +1,502 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observation
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sort"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/cache"
+	"github.com/datacommonsorg/mixer/internal/server/resource"
+	v2 "github.com/datacommonsorg/mixer/internal/server/v2"
+	"github.com/datacommonsorg/mixer/internal/server/v2/shared"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// AggregationMethod is a method to aggregate the observations of child places into their parent place.
+type AggregationMethod string
+
+const (
+	AggregateSum  AggregationMethod = "sum"
+	AggregateMean AggregationMethod = "mean"
+	// AggregatePopulationWeightedMean weights the observations of child places by their population.
+	AggregatePopulationWeightedMean AggregationMethod = "population_weighted_mean"
+	AggregateMin                    AggregationMethod = "min"
+	AggregateMax                    AggregationMethod = "max"
+)
+
+const (
+	// AggregateMeasurementMethodPrefix prefixes the measurement method of the facets of aggregated observations,
+	// e.g. dcAggregate/Sum.
+	AggregateMeasurementMethodPrefix = "dcAggregate/"
+	// Variable whose observations weight population-weighted means.
+	populationVariable = "Count_Person"
+	// Max number of concurrent child place requests.
+	aggregationConcurrency = 8
+)
+
+// aggregationMethodNames are the names of the methods in the measurement method of aggregated facets.
+var aggregationMethodNames = map[AggregationMethod]string{
+	AggregateSum:                    "Sum",
+	AggregateMean:                   "Mean",
+	AggregatePopulationWeightedMean: "PopulationWeightedMean",
+	AggregateMin:                    "Min",
+	AggregateMax:                    "Max",
+}
+
+// aggregationChildTypes are the child place types aggregated into places of a type when a request
+// doesn't specify one, in order of preference. Each type partitions the parent places.
+var aggregationChildTypes = map[string][]string{
+	"Continent":           {"Country"},
+	"Country":             {"State", "AdministrativeArea1", "County", "AdministrativeArea2"},
+	"State":               {"County"},
+	"AdministrativeArea1": {"AdministrativeArea2"},
+	"EurostatNUTS1":       {"EurostatNUTS2", "EurostatNUTS3"},
+	"EurostatNUTS2":       {"EurostatNUTS3"},
+}
+
+// Aggregation is the aggregation of child places selected by an observation request.
+type Aggregation struct {
+	Method AggregationMethod
+	// Type of the child places, empty for the default types of the parent places.
+	ChildType string
+}
+
+// ParseAggregation parses the aggregation of an observation request.
+// It returns nil if the request doesn't request an aggregation.
+func ParseAggregation(aggregation *pbv2.Aggregation) (*Aggregation, error) {
+	if aggregation == nil {
+		return nil, nil
+	}
+	method := AggregationMethod(aggregation.GetMethod())
+	if _, ok := aggregationMethodNames[method]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid aggregation method %q, want one of sum, mean, population_weighted_mean, min, max", aggregation.GetMethod())
+	}
+	return &Aggregation{Method: method, ChildType: aggregation.GetChildType()}, nil
+}
+
+// AggregationSource fetches the data that observations are aggregated from.
+type AggregationSource interface {
+	ObservationSource
+	// ChildPlaces returns the child places of a type of each parent place.
+	ChildPlaces(ctx context.Context, parents []string, childType string) (map[string][]string, error)
+}
+
+// AggregateHoles aggregates the observations of child places into the requested places that have
+// no observations of a variable in a response, if the request has an aggregation.
+// It returns nil if there is nothing to aggregate.
+//
+// Sums and means are only aggregated for parent places whose child places all have observations,
+// for the dates that they all have data for, so that aggregates don't mix dates or miss places.
+// Min and max are aggregated from the child places with observations, for the dates that they all have data for.
+// Aggregated observations have their own facet, whose measurement method starts with dcAggregate/.
+func AggregateHoles(
+	ctx context.Context,
+	source AggregationSource,
+	in *pbv2.ObservationRequest,
+	resp *pbv2.ObservationResponse,
+) (*pbv2.ObservationResponse, error) {
+	aggregation, err := ParseAggregation(in.GetAggregation())
+	if err != nil || aggregation == nil {
+		return nil, err
+	}
+	if in.GetVariable().GetFormula() != "" {
+		return nil, nil
+	}
+	var selectDate, selectValue bool
+	for _, item := range in.GetSelect() {
+		selectDate = selectDate || item == "date"
+		selectValue = selectValue || item == "value"
+	}
+	if !selectDate || !selectValue {
+		return nil, status.Errorf(codes.InvalidArgument, "aggregation requires selecting 'date' and 'value'")
+	}
+
+	// Resolve the parent places.
+	parents, parentType := in.GetEntity().GetDcids(), ""
+	if expr := in.GetEntity().GetExpression(); expr != "" {
+		containedInPlace, err := v2.ParseContainedInPlace(expr)
+		if err != nil {
+			return nil, err
+		}
+		parentType = containedInPlace.ChildPlaceType
+		if containedInPlace.Ancestor == parentType {
+			// Places of a type aren't resolved for aggregation.
+			return nil, nil
+		}
+		children, err := source.ChildPlaces(ctx, []string{containedInPlace.Ancestor}, parentType)
+		if err != nil {
+			return nil, err
+		}
+		parents = children[containedInPlace.Ancestor]
+	}
+	childTypes := aggregationChildTypes[parentType]
+	if aggregation.ChildType != "" {
+		childTypes = []string{aggregation.ChildType}
+	} else if parentType == "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"aggregation of entity dcids requires aggregation.child_type")
+	}
+
+	// Variables of each parent place without observations.
+	holes := map[string][]string{}
+	for _, variable := range in.GetVariable().GetDcids() {
+		byEntity := resp.GetByVariable()[variable].GetByEntity()
+		for _, parent := range parents {
+			if len(byEntity[parent].GetOrderedFacets()) == 0 {
+				holes[parent] = append(holes[parent], variable)
+			}
+		}
+	}
+
+	result := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{},
+		Facets:     map[string]*pb.Facet{},
+	}
+	for _, childType := range childTypes {
+		if len(holes) == 0 {
+			break
+		}
+		if err := aggregateChildType(ctx, source, in, aggregation.Method, childType, holes, result); err != nil {
+			return nil, err
+		}
+	}
+	if len(result.ByVariable) == 0 {
+		return nil, nil
+	}
+
+	// Child observations are fetched for all dates and values, so that dates are aggregated consistently.
+	if err := util.FilterObservationsByRequestDate(result, in.GetDate()); err != nil {
+		return nil, err
+	}
+	valueFilter, err := util.ParseValueFilter(in.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	util.FilterObservationsByValue(result, valueFilter)
+	return result, nil
+}
+
+// aggregateChildType aggregates the observations of child places of a type into the holes of parent places,
+// and adds them to result. Filled holes are removed from holes.
+func aggregateChildType(
+	ctx context.Context,
+	source AggregationSource,
+	in *pbv2.ObservationRequest,
+	method AggregationMethod,
+	childType string,
+	holes map[string][]string,
+	result *pbv2.ObservationResponse,
+) error {
+	parents := sortedKeys(holes)
+	children, err := source.ChildPlaces(ctx, parents, childType)
+	if err != nil {
+		return err
+	}
+	childSet, variableSet := map[string]struct{}{}, map[string]struct{}{}
+	for _, parent := range parents {
+		for _, child := range children[parent] {
+			childSet[child] = struct{}{}
+		}
+		for _, variable := range holes[parent] {
+			variableSet[variable] = struct{}{}
+		}
+	}
+	if len(childSet) == 0 {
+		return nil
+	}
+	childDcids := sortedKeys(childSet)
+
+	selects := []string{"variable", "entity", "date", "value"}
+	childResp, err := source.Observation(ctx, &pbv2.ObservationRequest{
+		Variable: &pbv2.DcidOrExpression{Dcids: sortedKeys(variableSet)},
+		Entity:   &pbv2.DcidOrExpression{Dcids: childDcids},
+		Filter:   in.GetFilter(),
+		Select:   selects,
+	})
+	if err != nil {
+		return err
+	}
+	var weights *pbv2.VariableObservation
+	if method == AggregatePopulationWeightedMean {
+		weightResp, err := source.Observation(ctx, &pbv2.ObservationRequest{
+			Variable: &pbv2.DcidOrExpression{Dcids: []string{populationVariable}},
+			Entity:   &pbv2.DcidOrExpression{Dcids: childDcids},
+			Select:   selects,
+		})
+		if err != nil {
+			return err
+		}
+		weights = weightResp.GetByVariable()[populationVariable]
+	}
+
+	for _, parent := range parents {
+		remaining := []string{}
+		for _, variable := range holes[parent] {
+			facet, facetObs := aggregatePlace(method, children[parent], childResp, variable, weights)
+			if facetObs == nil {
+				remaining = append(remaining, variable)
+				continue
+			}
+			if result.ByVariable[variable] == nil {
+				result.ByVariable[variable] = &pbv2.VariableObservation{ByEntity: map[string]*pbv2.EntityObservation{}}
+			}
+			result.ByVariable[variable].ByEntity[parent] = &pbv2.EntityObservation{
+				OrderedFacets: []*pbv2.FacetObservation{facetObs},
+			}
+			result.Facets[facetObs.FacetId] = facet
+		}
+		if len(remaining) == 0 {
+			delete(holes, parent)
+		} else {
+			holes[parent] = remaining
+		}
+	}
+	return nil
+}
+
+// aggregatePlace aggregates the observations of a variable for the child places of a parent place.
+// Only child places with facets of the same provenance, unit, scaling factor and observation period
+// are aggregated: those of the most child places, using the preferred such facet of each child place.
+// Sums and means require all child places to have observations, min and max only some.
+// It returns a nil facet observation if there is nothing to aggregate.
+func aggregatePlace(
+	method AggregationMethod,
+	children []string,
+	childResp *pbv2.ObservationResponse,
+	variable string,
+	weights *pbv2.VariableObservation,
+) (*pb.Facet, *pbv2.FacetObservation) {
+	type facetGroup struct {
+		facet  *pb.Facet
+		series map[string]*pbv2.FacetObservation
+	}
+	groups := map[string]*facetGroup{}
+	// Groups in the order they are found, so that ties go to the preferred facets of the first child places.
+	orderedGroups := []*facetGroup{}
+	sortedChildren := append([]string{}, children...)
+	sort.Strings(sortedChildren)
+	for _, child := range sortedChildren {
+		for _, facetObs := range childResp.GetByVariable()[variable].GetByEntity()[child].GetOrderedFacets() {
+			facet := aggregateFacet(method, childResp.GetFacets()[facetObs.GetFacetId()])
+			key := util.GetFacetID(facet)
+			group, ok := groups[key]
+			if !ok {
+				group = &facetGroup{facet: facet, series: map[string]*pbv2.FacetObservation{}}
+				groups[key] = group
+				orderedGroups = append(orderedGroups, group)
+			}
+			if _, ok := group.series[child]; !ok {
+				group.series[child] = facetObs
+			}
+		}
+	}
+	var best *facetGroup
+	for _, group := range orderedGroups {
+		if best == nil || len(group.series) > len(best.series) {
+			best = group
+		}
+	}
+	if best == nil {
+		return nil, nil
+	}
+	if method != AggregateMin && method != AggregateMax && len(best.series) < len(sortedChildren) {
+		// Sums and means of some child places would be wrong for the parent place.
+		return nil, nil
+	}
+
+	// Values of each date, by child place.
+	dateValues := map[string]map[string]float64{}
+	for child, series := range best.series {
+		for _, obs := range series.GetObservations() {
+			if obs.Value == nil {
+				continue
+			}
+			if dateValues[obs.GetDate()] == nil {
+				dateValues[obs.GetDate()] = map[string]float64{}
+			}
+			dateValues[obs.GetDate()][child] = obs.GetValue()
+		}
+	}
+	dates := sortedKeys(dateValues)
+
+	observations := []*pb.PointStat{}
+	for _, date := range dates {
+		values := dateValues[date]
+		if len(values) < len(best.series) {
+			continue
+		}
+		value, ok := aggregateValues(method, date, values, weights)
+		if !ok {
+			continue
+		}
+		observations = append(observations, &pb.PointStat{Date: date, Value: proto.Float64(value)})
+	}
+	if len(observations) == 0 {
+		return nil, nil
+	}
+	return best.facet, &pbv2.FacetObservation{
+		FacetId:      util.GetFacetID(best.facet),
+		Observations: observations,
+		ObsCount:     int32(len(observations)),
+		EarliestDate: observations[0].GetDate(),
+		LatestDate:   observations[len(observations)-1].GetDate(),
+	}
+}
+
+// aggregateFacet returns the facet of observations aggregated from observations of a facet.
+func aggregateFacet(method AggregationMethod, facet *pb.Facet) *pb.Facet {
+	return &pb.Facet{
+		ImportName:        facet.GetImportName(),
+		ProvenanceUrl:     facet.GetProvenanceUrl(),
+		MeasurementMethod: AggregateMeasurementMethodPrefix + aggregationMethodNames[method],
+		ObservationPeriod: facet.GetObservationPeriod(),
+		ScalingFactor:     facet.GetScalingFactor(),
+		Unit:              facet.GetUnit(),
+	}
+}
+
+// aggregateValues aggregates the values of child places for a date.
+// Population weights are the populations for the date, or the latest populations of child places without one.
+// It returns false if a child place has no population for a population-weighted mean.
+func aggregateValues(method AggregationMethod, date string, values map[string]float64, weights *pbv2.VariableObservation) (float64, bool) {
+	switch method {
+	case AggregateSum, AggregateMean:
+		sum := 0.0
+		for _, value := range values {
+			sum += value
+		}
+		if method == AggregateMean {
+			return sum / float64(len(values)), true
+		}
+		return sum, true
+	case AggregateMin:
+		result := math.Inf(1)
+		for _, value := range values {
+			result = math.Min(result, value)
+		}
+		return result, true
+	case AggregateMax:
+		result := math.Inf(-1)
+		for _, value := range values {
+			result = math.Max(result, value)
+		}
+		return result, true
+	case AggregatePopulationWeightedMean:
+		sum, totalWeight := 0.0, 0.0
+		for child, value := range values {
+			weight, ok := populationWeight(weights.GetByEntity()[child], date)
+			if !ok {
+				return 0, false
+			}
+			sum += weight * value
+			totalWeight += weight
+		}
+		if totalWeight == 0 {
+			return 0, false
+		}
+		return sum / totalWeight, true
+	}
+	return 0, false
+}
+
+// populationWeight returns the population of a place for a date from its preferred facet,
+// or its latest population if there is none for the date.
+func populationWeight(entityObs *pbv2.EntityObservation, date string) (float64, bool) {
+	facets := entityObs.GetOrderedFacets()
+	if len(facets) == 0 || len(facets[0].GetObservations()) == 0 {
+		return 0, false
+	}
+	observations := facets[0].GetObservations()
+	for _, obs := range observations {
+		if obs.GetDate() == date {
+			return obs.GetValue(), true
+		}
+	}
+	return observations[len(observations)-1].GetValue(), true
+}
+
+// sortedKeys returns the keys of a map in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// v2Source fetches the data that V2 observations are aggregated, normalized and calculated from.
+type v2Source struct {
+	store      *store.Store
+	cachedata  *cache.Cache
+	metadata   *resource.Metadata
+	httpClient *http.Client
+}
+
+func (s *v2Source) ChildPlaces(ctx context.Context, parents []string, childType string) (map[string][]string, error) {
+	result := make([][]string, len(parents))
+	errGroup, errCtx := errgroup.WithContext(ctx)
+	errGroup.SetLimit(aggregationConcurrency)
+	for i, parent := range parents {
+		i, parent := i, parent
+		errGroup.Go(func() error {
+			children, err := shared.FetchChildPlaces(
+				errCtx, s.store, s.metadata, s.httpClient, s.metadata.RemoteMixerDomain, parent, childType)
+			if err != nil {
+				return err
+			}
+			result[i] = children
+			return nil
+		})
+	}
+	if err := errGroup.Wait(); err != nil {
+		return nil, err
+	}
+	children := map[string][]string{}
+	for i, parent := range parents {
+		children[parent] = result[i]
+	}
+	return children, nil
+}
+
+func (s *v2Source) Observation(ctx context.Context, req *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
+	return ObservationInternal(ctx, s.store, s.cachedata, s.metadata, s.httpClient, req)
+}
+
+// MaybeAggregateHoles aggregates the observations of child places into the places without observations
+// in a V2 observation response, if the request has an aggregation. See AggregateHoles.
+func MaybeAggregateHoles(
+	ctx context.Context,
+	store *store.Store,
+	cachedata *cache.Cache,
+	metadata *resource.Metadata,
+	httpClient *http.Client,
+	inputReq *pbv2.ObservationRequest,
+	inputResp *pbv2.ObservationResponse,
+) (*pbv2.ObservationResponse, error) {
+	source := &v2Source{store: store, cachedata: cachedata, metadata: metadata, httpClient: httpClient}
+	return AggregateHoles(ctx, source, inputReq, inputResp)
+}
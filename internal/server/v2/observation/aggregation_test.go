@@ -0,0 +1,267 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observation
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// fakeAggregationSource serves child places and observations from maps.
+type fakeAggregationSource struct {
+	// Child places keyed by child type and parent.
+	children map[string]map[string][]string
+	// Observations of a single facet keyed by variable, entity and date.
+	obs   map[string]map[string]map[string]float64
+	facet *pb.Facet
+}
+
+func (s *fakeAggregationSource) ChildPlaces(ctx context.Context, parents []string, childType string) (map[string][]string, error) {
+	result := map[string][]string{}
+	for _, parent := range parents {
+		result[parent] = s.children[childType][parent]
+	}
+	return result, nil
+}
+
+func (s *fakeAggregationSource) Observation(ctx context.Context, req *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
+	facetID := util.GetFacetID(s.facet)
+	resp := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{},
+		Facets:     map[string]*pb.Facet{facetID: s.facet},
+	}
+	for _, variable := range req.GetVariable().GetDcids() {
+		resp.ByVariable[variable] = &pbv2.VariableObservation{ByEntity: map[string]*pbv2.EntityObservation{}}
+		for _, entity := range req.GetEntity().GetDcids() {
+			values := s.obs[variable][entity]
+			if len(values) == 0 {
+				continue
+			}
+			facetObs := &pbv2.FacetObservation{FacetId: facetID}
+			for _, date := range sortedKeys(values) {
+				facetObs.Observations = append(facetObs.Observations, &pb.PointStat{Date: date, Value: proto.Float64(values[date])})
+			}
+			resp.ByVariable[variable].ByEntity[entity] = &pbv2.EntityObservation{OrderedFacets: []*pbv2.FacetObservation{facetObs}}
+		}
+	}
+	return resp, nil
+}
+
+func TestAggregateHoles(t *testing.T) {
+	source := &fakeAggregationSource{
+		children: map[string]map[string][]string{
+			"State": {"country/USA": {"geoId/01", "geoId/02", "geoId/04"}},
+			"County": {
+				"geoId/01": {"geoId/01001", "geoId/01003"},
+				"geoId/02": {"geoId/02013"},
+				// geoId/04015 has no observations, so geoId/04 is only aggregated by min and max.
+				"geoId/04": {"geoId/04013", "geoId/04015"},
+			},
+		},
+		obs: map[string]map[string]map[string]float64{
+			"Count_Farm": {
+				"geoId/01001": {"2017": 10, "2022": 20},
+				"geoId/01003": {"2017": 30, "2022": 40},
+				// 2017 is missing, so it isn't aggregated for geoId/02.
+				"geoId/02013": {"2022": 5},
+				"geoId/04013": {"2022": 15},
+			},
+			"Count_Person": {
+				"geoId/01001": {"2022": 100},
+				"geoId/01003": {"2022": 300},
+				"geoId/02013": {"2022": 50},
+				"geoId/04013": {"2022": 80},
+			},
+			"Median_Age_Person": {
+				"geoId/01001": {"2022": 30},
+				"geoId/01003": {"2022": 40},
+				"geoId/02013": {"2022": 50},
+				"geoId/04013": {"2022": 35},
+			},
+		},
+		facet: &pb.Facet{ImportName: "USDA", ProvenanceUrl: "usda.gov", ObservationPeriod: "P1Y"},
+	}
+	// observations returns the response for observations of a facet keyed by variable, entity and date.
+	observations := func(facet *pb.Facet, obs map[string]map[string]map[string]float64) *pbv2.ObservationResponse {
+		facetID := util.GetFacetID(facet)
+		resp := &pbv2.ObservationResponse{ByVariable: map[string]*pbv2.VariableObservation{}, Facets: map[string]*pb.Facet{facetID: facet}}
+		for variable, byEntity := range obs {
+			resp.ByVariable[variable] = &pbv2.VariableObservation{ByEntity: map[string]*pbv2.EntityObservation{}}
+			for entity, values := range byEntity {
+				facetObs := &pbv2.FacetObservation{FacetId: facetID}
+				for _, date := range sortedKeys(values) {
+					facetObs.Observations = append(facetObs.Observations, &pb.PointStat{Date: date, Value: proto.Float64(values[date])})
+				}
+				facetObs.ObsCount = int32(len(facetObs.Observations))
+				facetObs.EarliestDate = facetObs.Observations[0].GetDate()
+				facetObs.LatestDate = facetObs.Observations[len(facetObs.Observations)-1].GetDate()
+				resp.ByVariable[variable].ByEntity[entity] = &pbv2.EntityObservation{OrderedFacets: []*pbv2.FacetObservation{facetObs}}
+			}
+		}
+		return resp
+	}
+	aggregateFacet := func(method string) *pb.Facet {
+		return &pb.Facet{ImportName: "USDA", ProvenanceUrl: "usda.gov", ObservationPeriod: "P1Y", MeasurementMethod: "dcAggregate/" + method}
+	}
+	request := func(variable, date string, aggregation *pbv2.Aggregation) *pbv2.ObservationRequest {
+		return &pbv2.ObservationRequest{
+			Variable:    &pbv2.DcidOrExpression{Dcids: []string{variable}},
+			Entity:      &pbv2.DcidOrExpression{Expression: "country/USA<-containedInPlace+{typeOf:State}"},
+			Date:        date,
+			Select:      []string{"variable", "entity", "date", "value"},
+			Aggregation: aggregation,
+		}
+	}
+
+	for _, tc := range []struct {
+		name string
+		in   *pbv2.ObservationRequest
+		resp *pbv2.ObservationResponse
+		want *pbv2.ObservationResponse
+	}{
+		{
+			name: "no aggregation",
+			in:   request("Count_Farm", "", nil),
+		},
+		{
+			name: "sum",
+			in:   request("Count_Farm", "", &pbv2.Aggregation{Method: "sum"}),
+			want: observations(aggregateFacet("Sum"), map[string]map[string]map[string]float64{
+				"Count_Farm": {
+					"geoId/01": {"2017": 40, "2022": 60},
+					"geoId/02": {"2022": 5},
+				},
+			}),
+		},
+		{
+			name: "latest max",
+			in:   request("Count_Farm", "LATEST", &pbv2.Aggregation{Method: "max", ChildType: "County"}),
+			want: observations(aggregateFacet("Max"), map[string]map[string]map[string]float64{
+				"Count_Farm": {
+					"geoId/01": {"2022": 40},
+					"geoId/02": {"2022": 5},
+					"geoId/04": {"2022": 15},
+				},
+			}),
+		},
+		{
+			name: "population weighted mean",
+			in:   request("Median_Age_Person", "", &pbv2.Aggregation{Method: "population_weighted_mean"}),
+			want: observations(aggregateFacet("PopulationWeightedMean"), map[string]map[string]map[string]float64{
+				"Median_Age_Person": {
+					"geoId/01": {"2022": 37.5},
+					"geoId/02": {"2022": 50},
+				},
+			}),
+		},
+		{
+			name: "places with observations",
+			in:   request("Count_Farm", "2017", &pbv2.Aggregation{Method: "mean"}),
+			resp: observations(source.facet, map[string]map[string]map[string]float64{
+				"Count_Farm": {"geoId/02": {"2017": 7}},
+			}),
+			want: observations(aggregateFacet("Mean"), map[string]map[string]map[string]float64{
+				"Count_Farm": {"geoId/01": {"2017": 20}},
+			}),
+		},
+	} {
+		resp := tc.resp
+		if resp == nil {
+			resp = &pbv2.ObservationResponse{}
+		}
+		got, err := AggregateHoles(context.Background(), source, tc.in, resp)
+		if err != nil {
+			t.Fatalf("AggregateHoles error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+
+	for _, in := range []*pbv2.ObservationRequest{
+		request("Count_Farm", "", &pbv2.Aggregation{Method: "median"}),
+		request("Count_Farm", "", &pbv2.Aggregation{}),
+		{
+			Variable:    &pbv2.DcidOrExpression{Dcids: []string{"Count_Farm"}},
+			Entity:      &pbv2.DcidOrExpression{Dcids: []string{"geoId/01"}},
+			Select:      []string{"variable", "entity", "date", "value"},
+			Aggregation: &pbv2.Aggregation{Method: "sum"},
+		},
+		{
+			Variable:    &pbv2.DcidOrExpression{Dcids: []string{"Count_Farm"}},
+			Entity:      &pbv2.DcidOrExpression{Dcids: []string{"geoId/01"}},
+			Select:      []string{"variable", "entity", "facet"},
+			Aggregation: &pbv2.Aggregation{Method: "sum", ChildType: "County"},
+		},
+	} {
+		if _, err := AggregateHoles(context.Background(), source, in, &pbv2.ObservationResponse{}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("AggregateHoles(%v) error = %v, want InvalidArgument", in.GetAggregation(), err)
+		}
+	}
+}
+
+func TestAggregatePlace(t *testing.T) {
+	census := &pb.Facet{ImportName: "CensusACS", ObservationPeriod: "P1Y"}
+	bls := &pb.Facet{ImportName: "BLS", ObservationPeriod: "P1Y"}
+	facetObs := func(facet *pb.Facet, value float64) *pbv2.FacetObservation {
+		return &pbv2.FacetObservation{
+			FacetId:      util.GetFacetID(facet),
+			Observations: []*pb.PointStat{{Date: "2022", Value: proto.Float64(value)}},
+		}
+	}
+	childResp := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{
+			"Count_Person_Employed": {ByEntity: map[string]*pbv2.EntityObservation{
+				"geoId/01001": {OrderedFacets: []*pbv2.FacetObservation{facetObs(census, 10)}},
+				// The preferred facet of geoId/01003 differs, but it has census observations too.
+				"geoId/01003": {OrderedFacets: []*pbv2.FacetObservation{facetObs(bls, 25), facetObs(census, 20)}},
+				"geoId/01005": {OrderedFacets: []*pbv2.FacetObservation{facetObs(bls, 35)}},
+			}},
+		},
+		Facets: map[string]*pb.Facet{util.GetFacetID(census): census, util.GetFacetID(bls): bls},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		method   AggregationMethod
+		children []string
+		want     float64
+	}{
+		{"sum of the facet of the most child places", AggregateSum, []string{"geoId/01001", "geoId/01003"}, 30},
+		{"sum of child places without a common facet", AggregateSum, []string{"geoId/01001", "geoId/01003", "geoId/01005"}, 0},
+		// Both facets have two child places, the one of the first child place is used.
+		{"max of some child places", AggregateMax, []string{"geoId/01001", "geoId/01003", "geoId/01005"}, 20},
+	} {
+		_, got := aggregatePlace(tc.method, tc.children, childResp, "Count_Person_Employed", nil)
+		if tc.want == 0 {
+			if got != nil {
+				t.Errorf("aggregatePlace(%s) = %v, want nil", tc.name, got)
+			}
+			continue
+		}
+		if len(got.GetObservations()) != 1 || got.GetObservations()[0].GetValue() != tc.want {
+			t.Errorf("aggregatePlace(%s) = %v, want %v", tc.name, got.GetObservations(), tc.want)
+		}
+	}
+}
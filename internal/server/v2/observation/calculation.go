@@ -25,6 +25,7 @@ import (
 	"github.com/datacommonsorg/mixer/internal/server/resource"
 	"github.com/datacommonsorg/mixer/internal/server/statvar/formula"
 	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/util"
 )
 
 type Equation struct {
@@ -50,11 +51,20 @@ func Calculate(
 	if len(variableFormula.StatVars) == 0 {
 		return nil, fmt.Errorf("formula missing variables")
 	}
+	valueFilter, err := util.ParseValueFilter(inputReq.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	// The value filter applies to the calculated values, not to the inputs.
+	// Time functions use the observations of other dates, so the date applies to the calculated values too.
+	date := inputReq.GetDate()
+	if variableFormula.HasTimeFunctions {
+		date = ""
+	}
 	newReq := &pbv2.ObservationRequest{
 		Variable: &pbv2.DcidOrExpression{Dcids: variableFormula.StatVars},
 		Entity:   entity,
-		Date:     inputReq.GetDate(),
-		Value:    inputReq.GetValue(),
+		Date:     date,
 		Filter:   inputReq.GetFilter(),
 		Select:   inputReq.GetSelect(),
 	}
@@ -62,7 +72,17 @@ func Calculate(
 	if err != nil {
 		return nil, err
 	}
-	return EvalExpr(variableFormula, inputObs, equation)
+	resp, err := EvalExpr(variableFormula, inputObs, equation)
+	if err != nil {
+		return nil, err
+	}
+	if date != inputReq.GetDate() {
+		if err := util.FilterObservationsByRequestDate(resp, inputReq.GetDate()); err != nil {
+			return nil, err
+		}
+	}
+	util.FilterObservationsByValue(resp, valueFilter)
+	return resp, nil
 }
 
 // Detects holes in a V2ObservationResponse and attempts to fill them using calculations.
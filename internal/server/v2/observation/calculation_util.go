@@ -15,9 +15,12 @@
 package observation
 
 import (
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/token"
+	"go/types"
+	"math"
 	"strconv"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
@@ -31,6 +34,8 @@ import (
 type intermediateObsResponse struct {
 	variableObs *pbv2.VariableObservation
 	constantObs *float64
+	// Value of a VariableObservation where it has none, e.g. 0 for coalesce(Count_Person, 0).
+	fallback *float64
 }
 
 // Given an input ObservationResponse, generate a map of variable -> entities with missing data.
@@ -108,6 +113,9 @@ func filterObsByASTNode(
 	return &intermediateObsResponse{variableObs: result}
 }
 
+// errZeroDenominator is returned for divisions by zero. Observations divided by zero are dropped.
+var errZeroDenominator = errors.New("denominator cannot be zero")
+
 // Evaluate a binary operation.
 func evalOp(
 	x, y float64,
@@ -122,7 +130,7 @@ func evalOp(
 		return x * y, nil
 	case token.QUO:
 		if y == 0 {
-			return 0, fmt.Errorf("denominator cannot be zero")
+			return 0, errZeroDenominator
 		}
 		return x / y, nil
 	default:
@@ -130,31 +138,73 @@ func evalOp(
 	}
 }
 
-// Combine two PointStat series using an operator token.
+// A binaryFunc combines the values of two observations.
+type binaryFunc func(x, y float64) (float64, error)
+
+func opFunc(op token.Token) binaryFunc {
+	return func(x, y float64) (float64, error) {
+		return evalOp(x, y, op)
+	}
+}
+
 // Combine two PointStat series using an operator token.
 func mergePointStat(
 	x, y []*pb.PointStat,
 	op token.Token,
+) ([]*pb.PointStat, error) {
+	return combinePointStat(x, y, nil, nil, opFunc(op))
+}
+
+// Combine two PointStat series using a binaryFunc, at the dates of both series.
+// If a series has a fallback value, it is used at the dates of the other series that it is missing.
+func combinePointStat(
+	x, y []*pb.PointStat,
+	xFallback, yFallback *float64,
+	f binaryFunc,
 ) ([]*pb.PointStat, error) {
 	result := []*pb.PointStat{}
+	add := func(date string, xVal, yVal float64) error {
+		val, err := f(xVal, yVal)
+		if errors.Is(err, errZeroDenominator) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		result = append(result, &pb.PointStat{
+			Date:  date,
+			Value: proto.Float64(val),
+		})
+		return nil
+	}
 	xIdx, yIdx := 0, 0
-	for xIdx < len(x) && yIdx < len(y) {
-		xDate, yDate := x[xIdx].GetDate(), y[yIdx].GetDate()
-		if xDate < yDate {
+	for xIdx < len(x) || yIdx < len(y) {
+		var xDate, yDate string
+		if xIdx < len(x) {
+			xDate = x[xIdx].GetDate()
+		}
+		if yIdx < len(y) {
+			yDate = y[yIdx].GetDate()
+		}
+		switch {
+		case yIdx == len(y) || (xIdx < len(x) && xDate < yDate):
+			if yFallback != nil {
+				if err := add(xDate, x[xIdx].GetValue(), *yFallback); err != nil {
+					return nil, err
+				}
+			}
 			xIdx++
-		} else if yDate < xDate {
+		case xIdx == len(x) || yDate < xDate:
+			if xFallback != nil {
+				if err := add(yDate, *xFallback, y[yIdx].GetValue()); err != nil {
+					return nil, err
+				}
+			}
 			yIdx++
-		} else {
-			xVal := x[xIdx].GetValue()
-			yVal := y[yIdx].GetValue()
-			val, err := evalOp(xVal, yVal, op)
-			if err != nil {
+		default:
+			if err := add(xDate, x[xIdx].GetValue(), y[yIdx].GetValue()); err != nil {
 				return nil, err
 			}
-			result = append(result, &pb.PointStat{
-				Date:  xDate,
-				Value: proto.Float64(val),
-			})
 			xIdx++
 			yIdx++
 		}
@@ -162,42 +212,66 @@ func mergePointStat(
 	return result, nil
 }
 
-// Combine two VariableObservations using an operator token.
+// newFacetObservation returns a FacetObservation of a facet for observations sorted by date,
+// or nil if there are no observations.
+func newFacetObservation(facetID string, observations []*pb.PointStat) *pbv2.FacetObservation {
+	if len(observations) == 0 {
+		return nil
+	}
+	return &pbv2.FacetObservation{
+		FacetId:      facetID,
+		Observations: observations,
+		EarliestDate: observations[0].GetDate(),
+		LatestDate:   observations[len(observations)-1].GetDate(),
+		ObsCount:     int32(len(observations)),
+	}
+}
+
+// Combine two VariableObservations using a binaryFunc.
+// Observations are combined for the same entity, facet and date, see combinePointStat for fallbacks.
 func evalBinaryVariableObsExpr(
 	x, y *pbv2.VariableObservation,
-	op token.Token,
-) (*intermediateObsResponse, error) {
+	xFallback, yFallback *float64,
+	f binaryFunc,
+) (*pbv2.VariableObservation, error) {
 	result := &pbv2.VariableObservation{ByEntity: map[string]*pbv2.EntityObservation{}}
-	for entity, xEntityObs := range x.ByEntity {
-		yEntityObs, ok := y.ByEntity[entity]
-		if !ok {
-			continue
+	entities := map[string]struct{}{}
+	for entity := range x.GetByEntity() {
+		entities[entity] = struct{}{}
+	}
+	for entity := range y.GetByEntity() {
+		entities[entity] = struct{}{}
+	}
+	for entity := range entities {
+		xFacets := x.GetByEntity()[entity].GetOrderedFacets()
+		yFacets := y.GetByEntity()[entity].GetOrderedFacets()
+		// Facets are combined in the order of x, followed by the other facets of y.
+		facetIds := []string{}
+		xObs := map[string][]*pb.PointStat{}
+		yObs := map[string][]*pb.PointStat{}
+		for _, facetObs := range xFacets {
+			facetIds = append(facetIds, facetObs.GetFacetId())
+			xObs[facetObs.GetFacetId()] = facetObs.GetObservations()
+		}
+		for _, facetObs := range yFacets {
+			if _, ok := xObs[facetObs.GetFacetId()]; !ok {
+				facetIds = append(facetIds, facetObs.GetFacetId())
+			}
+			yObs[facetObs.GetFacetId()] = facetObs.GetObservations()
 		}
-		xFacets := xEntityObs.OrderedFacets
-		yFacets := yEntityObs.OrderedFacets
 		newOrderedFacets := []*pbv2.FacetObservation{}
-		for i := 0; i < len(xFacets); i++ {
-			for j := 0; j < len(yFacets); j++ {
-				if xFacets[i].GetFacetId() == yFacets[j].GetFacetId() {
-					newFacetId := xFacets[i].GetFacetId()
-					newPointStat, err := mergePointStat(
-						xFacets[i].Observations,
-						yFacets[j].Observations,
-						op,
-					)
-					if err != nil {
-						return nil, err
-					}
-					if len(newPointStat) > 0 {
-						newOrderedFacets = append(newOrderedFacets, &pbv2.FacetObservation{
-							FacetId:      newFacetId,
-							Observations: newPointStat,
-							EarliestDate: newPointStat[0].GetDate(),
-							LatestDate:   newPointStat[len(newPointStat)-1].GetDate(),
-							ObsCount:     int32(len(newPointStat)),
-						})
-					}
-				}
+		for _, facetId := range facetIds {
+			xFacetObs, xOk := xObs[facetId]
+			yFacetObs, yOk := yObs[facetId]
+			if (!xOk && xFallback == nil) || (!yOk && yFallback == nil) {
+				continue
+			}
+			newPointStat, err := combinePointStat(xFacetObs, yFacetObs, xFallback, yFallback, f)
+			if err != nil {
+				return nil, err
+			}
+			if facetObs := newFacetObservation(facetId, newPointStat); facetObs != nil {
+				newOrderedFacets = append(newOrderedFacets, facetObs)
 			}
 		}
 		if len(newOrderedFacets) > 0 {
@@ -206,48 +280,25 @@ func evalBinaryVariableObsExpr(
 			}
 		}
 	}
-	return &intermediateObsResponse{variableObs: result}, nil
+	return result, nil
 }
 
-// Combine one VariableObservation with one constant using an operator token.
-func evalBinaryVariableConstantNodeExpr(
+// mapVariableObs replaces the observations of each facet of a VariableObservation with the result of f.
+// Facets and entities left without observations are removed.
+func mapVariableObs(
 	variable *pbv2.VariableObservation,
-	constant *float64,
-	vFirst bool, // Whether the variable response is the first response in the expression.
-	op token.Token,
-) (*intermediateObsResponse, error) {
+	f func(observations []*pb.PointStat) ([]*pb.PointStat, error),
+) (*pbv2.VariableObservation, error) {
 	result := &pbv2.VariableObservation{ByEntity: map[string]*pbv2.EntityObservation{}}
-	for entity, iEntityObs := range variable.ByEntity {
-		facets := iEntityObs.OrderedFacets
+	for entity, entityObs := range variable.GetByEntity() {
 		newOrderedFacets := []*pbv2.FacetObservation{}
-		for i := 0; i < len(facets); i++ {
-			newFacetId := facets[i].GetFacetId()
-			newPointStat := []*pb.PointStat{}
-			for _, obs := range facets[i].Observations {
-				vVal := obs.GetValue()
-				var val float64
-				var err error
-				if vFirst {
-					val, err = evalOp(vVal, *constant, op)
-				} else {
-					val, err = evalOp(*constant, vVal, op)
-				}
-				if err != nil {
-					return nil, err
-				}
-				newPointStat = append(newPointStat, &pb.PointStat{
-					Date:  obs.GetDate(),
-					Value: proto.Float64(val),
-				})
+		for _, facetObs := range entityObs.GetOrderedFacets() {
+			newPointStat, err := f(facetObs.GetObservations())
+			if err != nil {
+				return nil, err
 			}
-			if len(newPointStat) > 0 {
-				newOrderedFacets = append(newOrderedFacets, &pbv2.FacetObservation{
-					FacetId:      newFacetId,
-					Observations: newPointStat,
-					EarliestDate: newPointStat[0].GetDate(),
-					LatestDate:   newPointStat[len(newPointStat)-1].GetDate(),
-					ObsCount:     int32(len(newPointStat)),
-				})
+			if newFacetObs := newFacetObservation(facetObs.GetFacetId(), newPointStat); newFacetObs != nil {
+				newOrderedFacets = append(newOrderedFacets, newFacetObs)
 			}
 		}
 		if len(newOrderedFacets) > 0 {
@@ -256,32 +307,283 @@ func evalBinaryVariableConstantNodeExpr(
 			}
 		}
 	}
-	return &intermediateObsResponse{variableObs: result}, nil
+	return result, nil
+}
+
+// Combine one VariableObservation with one constant using a binaryFunc.
+func evalBinaryVariableConstantNodeExpr(
+	variable *pbv2.VariableObservation,
+	constant *float64,
+	vFirst bool, // Whether the variable response is the first response in the expression.
+	f binaryFunc,
+) (*pbv2.VariableObservation, error) {
+	apply := func(vVal float64) (float64, error) {
+		if vFirst {
+			return f(vVal, *constant)
+		}
+		return f(*constant, vVal)
+	}
+	return mapVariableObs(variable, func(observations []*pb.PointStat) ([]*pb.PointStat, error) {
+		newPointStat := []*pb.PointStat{}
+		for _, obs := range observations {
+			val, err := apply(obs.GetValue())
+			if errors.Is(err, errZeroDenominator) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			newPointStat = append(newPointStat, &pb.PointStat{
+				Date:  obs.GetDate(),
+				Value: proto.Float64(val),
+			})
+		}
+		return newPointStat, nil
+	})
 }
 
 // Combine two intermediateObsResponses using an operator token.
-// variableObs are preferred over constantObs (though both shouldn't get set).
 func evalBinaryExpr(
 	x, y *intermediateObsResponse,
 	op token.Token,
 ) (*intermediateObsResponse, error) {
-	if (x.variableObs != nil) && (y.variableObs != nil) {
-		return evalBinaryVariableObsExpr(x.variableObs, y.variableObs, op)
+	return evalBinaryFunc(x, y, opFunc(op))
+}
+
+// Combine two intermediateObsResponses using a binaryFunc.
+// variableObs are preferred over constantObs (though both shouldn't get set).
+func evalBinaryFunc(
+	x, y *intermediateObsResponse,
+	f binaryFunc,
+) (*intermediateObsResponse, error) {
+	// The fallback of the result is the combination of the fallbacks or constants of both responses.
+	var fallback *float64
+	xDefault, yDefault := x.fallback, y.fallback
+	if x.variableObs == nil {
+		xDefault = x.constantObs
+	}
+	if y.variableObs == nil {
+		yDefault = y.constantObs
+	}
+	if xDefault != nil && yDefault != nil {
+		val, err := f(*xDefault, *yDefault)
+		switch {
+		case err == nil:
+			fallback = &val
+		case errors.Is(err, errZeroDenominator) && (x.variableObs != nil || y.variableObs != nil):
+			// Variables keep their observations, without a fallback.
+		default:
+			return nil, err
+		}
+	}
+
+	var variableObs *pbv2.VariableObservation
+	var err error
+	switch {
+	case (x.variableObs != nil) && (y.variableObs != nil):
+		variableObs, err = evalBinaryVariableObsExpr(x.variableObs, y.variableObs, x.fallback, y.fallback, f)
+	case (x.variableObs != nil) && (y.constantObs != nil):
+		variableObs, err = evalBinaryVariableConstantNodeExpr(x.variableObs, y.constantObs, true /*vFirst*/, f)
+	case (x.constantObs != nil) && (y.variableObs != nil):
+		variableObs, err = evalBinaryVariableConstantNodeExpr(y.variableObs, x.constantObs, false /*vFirst*/, f)
+	case (x.constantObs != nil) && (y.constantObs != nil):
+		return &intermediateObsResponse{constantObs: fallback}, nil
+	default:
+		return nil, fmt.Errorf("invalid binary expr")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &intermediateObsResponse{variableObs: variableObs, fallback: fallback}, nil
+}
+
+// Evaluate a function that maps each value of an intermediateObsResponse to a value,
+// or to no value if ok is false.
+func evalValueFunc(
+	x *intermediateObsResponse,
+	f func(float64) (val float64, ok bool),
+) (*intermediateObsResponse, error) {
+	mapValue := func(v *float64) *float64 {
+		if v == nil {
+			return nil
+		}
+		if val, ok := f(*v); ok {
+			return &val
+		}
+		return nil
+	}
+	if x.variableObs == nil {
+		constant := mapValue(x.constantObs)
+		if constant == nil {
+			return nil, fmt.Errorf("invalid constant %v", *x.constantObs)
+		}
+		return &intermediateObsResponse{constantObs: constant}, nil
+	}
+	variableObs, err := mapVariableObs(x.variableObs, func(observations []*pb.PointStat) ([]*pb.PointStat, error) {
+		newPointStat := []*pb.PointStat{}
+		for _, obs := range observations {
+			if val := mapValue(proto.Float64(obs.GetValue())); val != nil {
+				newPointStat = append(newPointStat, &pb.PointStat{Date: obs.GetDate(), Value: val})
+			}
+		}
+		return newPointStat, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &intermediateObsResponse{variableObs: variableObs, fallback: mapValue(x.fallback)}, nil
+}
+
+// Evaluate a function of the value of an intermediateObsResponse at each date and its value
+// a period before, or its fallback if it has no value then.
+func evalPeriodFunc(
+	x *intermediateObsResponse,
+	period *formula.Period,
+	f func(val, prevVal float64) (float64, bool),
+) (*intermediateObsResponse, error) {
+	if x.variableObs == nil {
+		return nil, fmt.Errorf("time functions require a variable")
+	}
+	variableObs, err := mapVariableObs(x.variableObs, func(observations []*pb.PointStat) ([]*pb.PointStat, error) {
+		values := map[string]float64{}
+		for _, obs := range observations {
+			values[obs.GetDate()] = obs.GetValue()
+		}
+		newPointStat := []*pb.PointStat{}
+		for _, obs := range observations {
+			prevDate, ok := period.Before(obs.GetDate())
+			if !ok {
+				continue
+			}
+			prevVal, ok := values[prevDate]
+			if !ok {
+				if x.fallback == nil {
+					continue
+				}
+				prevVal = *x.fallback
+			}
+			if val, ok := f(obs.GetValue(), prevVal); ok {
+				newPointStat = append(newPointStat, &pb.PointStat{Date: obs.GetDate(), Value: proto.Float64(val)})
+			}
+		}
+		return newPointStat, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &intermediateObsResponse{variableObs: variableObs}, nil
+}
+
+// Merge two PointStat series sorted by date, with the value of x at the dates of both.
+func coalescePointStat(x, y []*pb.PointStat) []*pb.PointStat {
+	result := []*pb.PointStat{}
+	xIdx, yIdx := 0, 0
+	for xIdx < len(x) || yIdx < len(y) {
+		switch {
+		case yIdx == len(y) || (xIdx < len(x) && x[xIdx].GetDate() < y[yIdx].GetDate()):
+			result = append(result, x[xIdx])
+			xIdx++
+		case xIdx == len(x) || y[yIdx].GetDate() < x[xIdx].GetDate():
+			result = append(result, y[yIdx])
+			yIdx++
+		default:
+			result = append(result, x[xIdx])
+			xIdx++
+			yIdx++
+		}
+	}
+	return result
+}
+
+// Evaluate coalesce, the value of the first argument with a value for each entity, facet and date.
+// A constant argument, or the fallback of an argument, is the fallback of the result,
+// so it applies where the result is combined with other variables.
+func evalCoalesce(args []*intermediateObsResponse) *intermediateObsResponse {
+	if args[0].variableObs == nil {
+		return args[0]
 	}
-	if (x.variableObs != nil) && (y.constantObs != nil) {
-		return evalBinaryVariableConstantNodeExpr(x.variableObs, y.constantObs, true /*vFirst*/, op)
+	// Observations keyed by entity and facet, with facets in order of appearance.
+	observations := map[string]map[string][]*pb.PointStat{}
+	facetIds := map[string][]string{}
+	result := &intermediateObsResponse{}
+	for _, arg := range args {
+		if arg.variableObs == nil {
+			result.fallback = arg.constantObs
+			break
+		}
+		for entity, entityObs := range arg.variableObs.GetByEntity() {
+			if _, ok := observations[entity]; !ok {
+				observations[entity] = map[string][]*pb.PointStat{}
+			}
+			for _, facetObs := range entityObs.GetOrderedFacets() {
+				facetId := facetObs.GetFacetId()
+				prev, ok := observations[entity][facetId]
+				if !ok {
+					facetIds[entity] = append(facetIds[entity], facetId)
+				}
+				observations[entity][facetId] = coalescePointStat(prev, facetObs.GetObservations())
+			}
+		}
+		if arg.fallback != nil {
+			result.fallback = arg.fallback
+			break
+		}
 	}
-	if (x.constantObs != nil) && (y.variableObs != nil) {
-		return evalBinaryVariableConstantNodeExpr(y.variableObs, x.constantObs, false /*vFirst*/, op)
+	result.variableObs = &pbv2.VariableObservation{ByEntity: map[string]*pbv2.EntityObservation{}}
+	for entity, byFacet := range observations {
+		entityObs := &pbv2.EntityObservation{}
+		for _, facetId := range facetIds[entity] {
+			if facetObs := newFacetObservation(facetId, byFacet[facetId]); facetObs != nil {
+				entityObs.OrderedFacets = append(entityObs.OrderedFacets, facetObs)
+			}
+		}
+		if len(entityObs.OrderedFacets) > 0 {
+			result.variableObs.ByEntity[entity] = entityObs
+		}
 	}
-	if (x.constantObs != nil) && (y.constantObs != nil) {
-		val, err := evalOp(*x.constantObs, *y.constantObs, op)
+	return result
+}
+
+// Evaluate a function call with the evaluated arguments.
+func evalCall(call *ast.CallExpr, args []*intermediateObsResponse) (*intermediateObsResponse, error) {
+	name := call.Fun.(*ast.Ident).Name
+	switch name {
+	case formula.FuncAbs:
+		return evalValueFunc(args[0], func(x float64) (float64, bool) { return math.Abs(x), true })
+	case formula.FuncLog:
+		// The logarithm of non-positive values is undefined, so they are dropped.
+		return evalValueFunc(args[0], func(x float64) (float64, bool) { return math.Log(x), x > 0 })
+	case formula.FuncMin, formula.FuncMax, formula.FuncSum:
+		f := map[string]binaryFunc{
+			formula.FuncMin: func(x, y float64) (float64, error) { return math.Min(x, y), nil },
+			formula.FuncMax: func(x, y float64) (float64, error) { return math.Max(x, y), nil },
+			formula.FuncSum: opFunc(token.ADD),
+		}[name]
+		result := args[0]
+		for _, arg := range args[1:] {
+			var err error
+			if result, err = evalBinaryFunc(result, arg, f); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	case formula.FuncCoalesce:
+		return evalCoalesce(args), nil
+	case formula.FuncLag, formula.FuncPctChange:
+		period, err := formula.ParsePeriod(types.ExprString(call.Args[1]))
 		if err != nil {
 			return nil, err
 		}
-		return &intermediateObsResponse{constantObs: &val}, nil
+		if name == formula.FuncLag {
+			return evalPeriodFunc(args[0], period, func(_, prevVal float64) (float64, bool) { return prevVal, true })
+		}
+		// The change from zero is undefined, so it is dropped.
+		return evalPeriodFunc(args[0], period, func(val, prevVal float64) (float64, bool) {
+			return (val - prevVal) / prevVal, prevVal != 0
+		})
+	default:
+		return nil, fmt.Errorf("unsupported function %s", name)
 	}
-	return nil, fmt.Errorf("invalid binary expr")
 }
 
 // Evaluate a calculation given a formula and input observations.
@@ -298,7 +600,9 @@ func EvalExpr(
 		return nil, fmt.Errorf("nil calculation response")
 	}
 
-	calculatedResp, err := formatCalculatedResponse(intermediateResp.variableObs, inputObs.Facets, equation)
+	// Leaves share observations with the inputs, which may be evaluated concurrently with other formulas.
+	variableObs := proto.Clone(intermediateResp.variableObs).(*pbv2.VariableObservation)
+	calculatedResp, err := formatCalculatedResponse(variableObs, inputObs.Facets, equation)
 	if err != nil {
 		return nil, err
 	}
@@ -312,8 +616,8 @@ func evalExpr(
 	inputResp *pbv2.ObservationResponse,
 ) (*intermediateObsResponse, error) {
 	// If a node is of type *ast.Ident, it is a leaf with an obs value.
-	// Otherwise, it might be *ast.ParenExpr or *ast.BinaryExpr, so we continue recursing it to
-	// compute the obs value for the subtree..
+	// Otherwise, it might be *ast.ParenExpr, *ast.UnaryExpr, *ast.BinaryExpr or *ast.CallExpr,
+	// so we continue recursing it to compute the obs value for the subtree..
 	switch t := node.(type) {
 	case *ast.Ident:
 		return filterObsByASTNode(inputResp, leafData[node.(*ast.Ident).Name]), nil
@@ -335,6 +639,30 @@ func evalExpr(
 		return evalBinaryExpr(xObs, yObs, t.Op)
 	case *ast.ParenExpr:
 		return evalExpr(t.X, leafData, inputResp)
+	case *ast.UnaryExpr:
+		xObs, err := evalExpr(t.X, leafData, inputResp)
+		if err != nil {
+			return nil, err
+		}
+		if t.Op == token.SUB {
+			return evalValueFunc(xObs, func(x float64) (float64, bool) { return -x, true })
+		}
+		return xObs, nil
+	case *ast.CallExpr:
+		argExprs := t.Args
+		if formula.HasPeriodArg(t) {
+			// The period is used by the function, not evaluated.
+			argExprs = argExprs[:len(argExprs)-1]
+		}
+		args := []*intermediateObsResponse{}
+		for _, arg := range argExprs {
+			argObs, err := evalExpr(arg, leafData, inputResp)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, argObs)
+		}
+		return evalCall(t, args)
 	default:
 		return nil, fmt.Errorf("unsupported ast type %T", t)
 	}
@@ -352,7 +680,7 @@ func formatCalculatedResponse(
 		Facets: map[string]*pb.Facet{},
 	}
 
-	// Update Facets with IsDcAggregate=true.
+	// Update Facets with IsDcAggregate=true and the formula.
 	facetIdMap := map[string]string{}
 	for _, entityObs := range variableObs.ByEntity {
 		for _, facetObs := range entityObs.OrderedFacets {
@@ -368,6 +696,7 @@ func formatCalculatedResponse(
 			}
 			newFacet := proto.Clone(oldFacet).(*pb.Facet)
 			newFacet.IsDcAggregate = true
+			newFacet.Formula = equation.Formula
 			newFacetId = util.GetFacetID(newFacet)
 			facetObs.FacetId = newFacetId
 			resp.Facets[newFacetId] = newFacet
@@ -16,13 +16,16 @@ package observation
 
 import (
 	"go/token"
+	"math"
 	"reflect"
 	"testing"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
 	"github.com/datacommonsorg/mixer/internal/server/statvar/formula"
+	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
 )
 
 func TestFindObservationResponseHoles(t *testing.T) {
@@ -438,3 +441,56 @@ func TestEvalExpr(t *testing.T) {
 		}
 	}
 }
+
+func TestEvalExprFunctions(t *testing.T) {
+	// variableObs returns the observations of facetId1 keyed by entity and date.
+	variableObs := func(byEntity map[string]map[string]float64) *pbv2.VariableObservation {
+		result := &pbv2.VariableObservation{ByEntity: map[string]*pbv2.EntityObservation{}}
+		for entity, values := range byEntity {
+			result.ByEntity[entity] = &pbv2.EntityObservation{OrderedFacets: []*pbv2.FacetObservation{
+				facetObservations("facetId1", values),
+			}}
+		}
+		return result
+	}
+	inputResp := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{
+			"SV_1": variableObs(map[string]map[string]float64{
+				"geoId/01": {"2019": 10, "2020": 12, "2021": -3},
+			}),
+			"SV_2": variableObs(map[string]map[string]float64{
+				"geoId/01": {"2019": 5, "2021": 4},
+				"geoId/02": {"2020": 7},
+			}),
+		},
+		Facets: map[string]*pb.Facet{"facetId1": {ObservationPeriod: "P1Y"}},
+	}
+
+	for _, c := range []struct {
+		formula string
+		want    map[string]map[string]float64
+	}{
+		{"abs(SV_1)", map[string]map[string]float64{"geoId/01": {"2019": 10, "2020": 12, "2021": 3}}},
+		// The logarithm of a negative value is dropped.
+		{"log(SV_1)", map[string]map[string]float64{"geoId/01": {"2019": math.Log(10), "2020": math.Log(12)}}},
+		{"max(SV_1, SV_2, 11)", map[string]map[string]float64{"geoId/01": {"2019": 11, "2021": 11}}},
+		{"sum(SV_1, SV_2)", map[string]map[string]float64{"geoId/01": {"2019": 15, "2021": 1}}},
+		{"-SV_2", map[string]map[string]float64{"geoId/01": {"2019": -5, "2021": -4}, "geoId/02": {"2020": -7}}},
+		{"coalesce(SV_2, SV_1)", map[string]map[string]float64{"geoId/01": {"2019": 5, "2020": 12, "2021": 4}, "geoId/02": {"2020": 7}}},
+		{"SV_1 + coalesce(SV_2, 0)", map[string]map[string]float64{"geoId/01": {"2019": 15, "2020": 12, "2021": 1}}},
+		{"lag(SV_1, P1Y)", map[string]map[string]float64{"geoId/01": {"2020": 10, "2021": 12}}},
+		{"pct_change(SV_1, P1Y)", map[string]map[string]float64{"geoId/01": {"2020": 0.2, "2021": -1.25}}},
+	} {
+		f, err := formula.NewVariableFormula(c.formula)
+		if err != nil {
+			t.Fatalf("NewVariableFormula(%s) = %s", c.formula, err)
+		}
+		got, err := evalExpr(f.Expr, f.LeafData, inputResp)
+		if err != nil {
+			t.Fatalf("evalExpr(%s) = %s", c.formula, err)
+		}
+		if diff := cmp.Diff(got.variableObs, variableObs(c.want), protocmp.Transform()); diff != "" {
+			t.Errorf("evalExpr(%s) got diff %v", c.formula, diff)
+		}
+	}
+}
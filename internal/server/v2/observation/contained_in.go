@@ -185,7 +185,7 @@ func FetchContainedIn(
 	if sqldb.IsConnected(&store.SQLClient) {
 		if ancestor == childType {
 			sqlResult = initObservationResult(variables)
-			rows, err := store.SQLClient.GetObservationsByEntityType(ctx, variables, childType, queryDate)
+			rows, err := store.SQLClient.GetObservationsByEntityType(ctx, variables, childType, queryDate, nil)
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,105 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observation
+
+import (
+	"context"
+
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/statvar/formula"
+	"github.com/datacommonsorg/mixer/internal/server/v2/shared"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FormulaObservation evaluates the formula variable of an observation request, e.g.
+// Count_Person_Female / Count_Person, from the observations of its variables for the entities of the request.
+// The response is keyed by the formula.
+//
+// The variables are fetched for all dates, unless the request is for a single date and the formula has no
+// time functions, so that LATEST is the latest date with a value of the formula. The date and value filters
+// of the request apply to the values of the formula. Requests that select facets without dates and values
+// get the facets of the formula without observations.
+func FormulaObservation(
+	ctx context.Context,
+	source ObservationSource,
+	in *pbv2.ObservationRequest,
+) (*pbv2.ObservationResponse, error) {
+	var selectDate, selectValue, selectFacet bool
+	for _, item := range in.GetSelect() {
+		selectDate = selectDate || item == "date"
+		selectValue = selectValue || item == "value"
+		selectFacet = selectFacet || item == "facet"
+	}
+	if !(selectDate && selectValue) && !selectFacet {
+		return nil, status.Errorf(codes.InvalidArgument, "formulas require selecting 'date' and 'value', or 'facet'")
+	}
+	variableFormula, err := formula.NewVariableFormula(in.GetVariable().GetFormula())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(variableFormula.StatVars) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "formula missing variables")
+	}
+	valueFilter, err := util.ParseValueFilter(in.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	dateFilter, err := util.ParseDateFilter(in.GetDate())
+	if err != nil {
+		return nil, err
+	}
+
+	date := ""
+	if in.GetDate() != shared.LATEST && dateFilter == nil && !variableFormula.HasTimeFunctions {
+		date = in.GetDate()
+	}
+	inputObs, err := source.Observation(ctx, &pbv2.ObservationRequest{
+		Variable: &pbv2.DcidOrExpression{Dcids: variableFormula.StatVars},
+		Entity:   in.GetEntity(),
+		Date:     date,
+		Filter:   in.GetFilter(),
+		Select:   []string{"variable", "entity", "date", "value"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	equation := &Equation{Variable: in.GetVariable().GetFormula(), Formula: in.GetVariable().GetFormula()}
+	resp, err := EvalExpr(variableFormula, inputObs, equation)
+	if err != nil {
+		return nil, err
+	}
+	if err := util.FilterObservationsByRequestDate(resp, in.GetDate()); err != nil {
+		return nil, err
+	}
+	util.FilterObservationsByValue(resp, valueFilter)
+
+	// Like calculated observations, entities without observations are omitted.
+	for _, variableObs := range resp.GetByVariable() {
+		for entity, entityObs := range variableObs.GetByEntity() {
+			if len(entityObs.GetOrderedFacets()) == 0 {
+				delete(variableObs.ByEntity, entity)
+				continue
+			}
+			if !selectDate || !selectValue {
+				for _, facetObs := range entityObs.GetOrderedFacets() {
+					facetObs.Observations = nil
+				}
+			}
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observation
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestFormulaObservation(t *testing.T) {
+	facet := &pb.Facet{ImportName: "CensusPEP", ObservationPeriod: "P1Y"}
+	// variableObs returns the observations of a facet keyed by entity and date.
+	variableObs := func(facetID string, byEntity map[string]map[string]float64) *pbv2.VariableObservation {
+		result := &pbv2.VariableObservation{ByEntity: map[string]*pbv2.EntityObservation{}}
+		for entity, values := range byEntity {
+			result.ByEntity[entity] = &pbv2.EntityObservation{OrderedFacets: []*pbv2.FacetObservation{
+				facetObservations(facetID, values),
+			}}
+		}
+		return result
+	}
+	source := &fakeObservationSource{resp: &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{
+			"Count_Person_Female": variableObs("1", map[string]map[string]float64{
+				"geoId/01001": {"2019": 40, "2020": 60},
+				"geoId/01003": {"2019": 30, "2020": 35},
+				"geoId/01005": {"2020": 0},
+			}),
+			"Count_Person": variableObs("1", map[string]map[string]float64{
+				"geoId/01001": {"2019": 80, "2020": 100},
+				// The latest date with both variables is 2019.
+				"geoId/01003": {"2019": 50},
+				// Observations divided by zero are dropped.
+				"geoId/01005": {"2020": 0},
+			}),
+		},
+		Facets: map[string]*pb.Facet{"1": facet},
+	}}
+	const ratio = "Count_Person_Female / Count_Person"
+	request := func(variable, date string, selects ...string) *pbv2.ObservationRequest {
+		return &pbv2.ObservationRequest{
+			Variable: &pbv2.DcidOrExpression{Formula: variable},
+			Entity:   &pbv2.DcidOrExpression{Expression: "geoId/01<-containedInPlace+{typeOf:County}"},
+			Date:     date,
+			Select:   append([]string{"variable", "entity"}, selects...),
+		}
+	}
+	// Calculated facets record their formula.
+	response := func(variable string, byEntity map[string]map[string]float64) *pbv2.ObservationResponse {
+		calculatedFacet := &pb.Facet{ImportName: "CensusPEP", ObservationPeriod: "P1Y", IsDcAggregate: true, Formula: variable}
+		calculatedFacetID := util.GetFacetID(calculatedFacet)
+		return &pbv2.ObservationResponse{
+			ByVariable: map[string]*pbv2.VariableObservation{variable: variableObs(calculatedFacetID, byEntity)},
+			Facets:     map[string]*pb.Facet{calculatedFacetID: calculatedFacet},
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		in       *pbv2.ObservationRequest
+		wantDate string
+		want     *pbv2.ObservationResponse
+	}{
+		{
+			name: "all dates",
+			in:   request(ratio, "", "date", "value"),
+			want: response(ratio, map[string]map[string]float64{
+				"geoId/01001": {"2019": 0.5, "2020": 0.6},
+				"geoId/01003": {"2019": 0.6},
+			}),
+		},
+		{
+			name: "latest",
+			in:   request(ratio, "LATEST", "date", "value"),
+			want: response(ratio, map[string]map[string]float64{
+				"geoId/01001": {"2020": 0.6},
+				"geoId/01003": {"2019": 0.6},
+			}),
+		},
+		{
+			name:     "date",
+			in:       request(ratio, "2020", "date", "value"),
+			wantDate: "2020",
+			want: response(ratio, map[string]map[string]float64{
+				"geoId/01001": {"2020": 0.6},
+			}),
+		},
+		{
+			name: "time function",
+			in:   request("pct_change(Count_Person_Female, P1Y)", "2020", "date", "value"),
+			want: response("pct_change(Count_Person_Female, P1Y)", map[string]map[string]float64{
+				"geoId/01001": {"2020": 0.5},
+				"geoId/01003": {"2020": 35.0/30 - 1},
+			}),
+		},
+		{
+			name: "facet",
+			in:   request(ratio, "LATEST", "facet"),
+			want: func() *pbv2.ObservationResponse {
+				resp := response(ratio, map[string]map[string]float64{
+					"geoId/01001": {"2020": 0.6},
+					"geoId/01003": {"2019": 0.6},
+				})
+				for _, entityObs := range resp.ByVariable[ratio].ByEntity {
+					entityObs.OrderedFacets[0].Observations = nil
+				}
+				return resp
+			}(),
+		},
+	} {
+		got, err := FormulaObservation(context.Background(), source, tc.in)
+		if err != nil {
+			t.Fatalf("FormulaObservation error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+		if source.gotReq.GetDate() != tc.wantDate {
+			t.Errorf("FormulaObservation (%s) fetched date %q, want %q", tc.name, source.gotReq.GetDate(), tc.wantDate)
+		}
+	}
+
+	for _, in := range []*pbv2.ObservationRequest{
+		request("Count_Person_Female /", "", "date", "value"),
+		request("median(Count_Person)", "", "date", "value"),
+		request(ratio, ""),
+		request(ratio, "2020..2010", "date", "value"),
+	} {
+		if _, err := FormulaObservation(context.Background(), source, in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("FormulaObservation(%s, %s) error = %v, want InvalidArgument", in.GetVariable().GetFormula(), in.GetDate(), err)
+		}
+	}
+}
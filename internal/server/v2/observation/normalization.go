@@ -0,0 +1,206 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observation
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/cache"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"github.com/datacommonsorg/mixer/internal/server/resource"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Layouts of the observation dates matched with denominator dates.
+var denominatorDateLayouts = []string{"2006", "2006-01", "2006-01-02"}
+
+// ObservationSource fetches observations.
+type ObservationSource interface {
+	Observation(ctx context.Context, req *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error)
+}
+
+// NumeratorRequest returns the request for the observations that are divided by the denominator
+// of a request, or the request itself if it doesn't have a denominator.
+// The value filter applies to the ratios, so numerators are requested for all values.
+// The denominator is cleared, so that the numerators aren't divided by other mixers.
+func NumeratorRequest(in *pbv2.ObservationRequest) (*pbv2.ObservationRequest, error) {
+	if in.GetDenominator() == "" {
+		return in, nil
+	}
+	var selectDate, selectValue bool
+	for _, item := range in.GetSelect() {
+		selectDate = selectDate || item == "date"
+		selectValue = selectValue || item == "value"
+	}
+	if !selectDate || !selectValue {
+		return nil, status.Errorf(codes.InvalidArgument, "denominators require selecting 'date' and 'value'")
+	}
+	out := proto.Clone(in).(*pbv2.ObservationRequest)
+	out.Value = ""
+	out.Denominator = ""
+	return out, nil
+}
+
+// Normalize divides the observations of the response to the NumeratorRequest of a request by the observations
+// of the denominator of the request for the same entities, e.g. Count_Person for per capita values,
+// and then applies the value filter of the request. The response is modified in place.
+//
+// The denominator of each entity is from its best ranked facet in ranking.StatsRanking.
+// Each observation is divided by the denominator observation of the nearest date within a year,
+// the earlier one in case of ties. Observations without a denominator are removed.
+func Normalize(ctx context.Context, source ObservationSource, in *pbv2.ObservationRequest, resp *pbv2.ObservationResponse) error {
+	denominator := in.GetDenominator()
+	if denominator == "" {
+		return nil
+	}
+	valueFilter, err := util.ParseValueFilter(in.GetValue())
+	if err != nil {
+		return err
+	}
+	if err := divide(ctx, source, denominator, resp); err != nil {
+		return err
+	}
+	util.FilterObservationsByValue(resp, valueFilter)
+	return nil
+}
+
+// MaybeNormalize normalizes a V2 observation response, if the request has a denominator. See Normalize.
+func MaybeNormalize(
+	ctx context.Context,
+	store *store.Store,
+	cachedata *cache.Cache,
+	metadata *resource.Metadata,
+	httpClient *http.Client,
+	inputReq *pbv2.ObservationRequest,
+	inputResp *pbv2.ObservationResponse,
+) error {
+	source := &v2Source{store: store, cachedata: cachedata, metadata: metadata, httpClient: httpClient}
+	return Normalize(ctx, source, inputReq, inputResp)
+}
+
+// divide divides the observations of a response by the observations of a denominator variable.
+func divide(ctx context.Context, source ObservationSource, denominator string, resp *pbv2.ObservationResponse) error {
+	entitySet := map[string]struct{}{}
+	for _, variableObs := range resp.GetByVariable() {
+		for entity, entityObs := range variableObs.GetByEntity() {
+			if len(entityObs.GetOrderedFacets()) > 0 {
+				entitySet[entity] = struct{}{}
+			}
+		}
+	}
+	if len(entitySet) == 0 {
+		return nil
+	}
+	denominatorResp, err := source.Observation(ctx, &pbv2.ObservationRequest{
+		Variable: &pbv2.DcidOrExpression{Dcids: []string{denominator}},
+		Entity:   &pbv2.DcidOrExpression{Dcids: sortedKeys(entitySet)},
+		Select:   []string{"variable", "entity", "date", "value"},
+	})
+	if err != nil {
+		return err
+	}
+
+	denominators := map[string][]*pb.PointStat{}
+	for entity, entityObs := range denominatorResp.GetByVariable()[denominator].GetByEntity() {
+		denominators[entity] = bestRankedObservations(entityObs, denominatorResp.GetFacets())
+	}
+	for _, variableObs := range resp.GetByVariable() {
+		for entity, entityObs := range variableObs.GetByEntity() {
+			for _, facetObs := range entityObs.GetOrderedFacets() {
+				for _, obs := range facetObs.GetObservations() {
+					value, ok := nearestDateValue(denominators[entity], obs.GetDate())
+					if !ok || value == 0 || obs.Value == nil {
+						obs.Value = nil
+						continue
+					}
+					obs.Value = proto.Float64(obs.GetValue() / value)
+				}
+			}
+		}
+	}
+	util.FilterObservations(resp, func(_ string, obs *pb.PointStat) bool {
+		return obs.Value != nil
+	})
+	return nil
+}
+
+// bestRankedObservations returns the observations of the best ranked facet of an entity,
+// the first one in order in case of ties.
+func bestRankedObservations(entityObs *pbv2.EntityObservation, facets map[string]*pb.Facet) []*pb.PointStat {
+	var best *pbv2.FacetObservation
+	bestScore := 0
+	for _, facetObs := range entityObs.GetOrderedFacets() {
+		if len(facetObs.GetObservations()) == 0 {
+			continue
+		}
+		score := ranking.GetFacetScore(facets[facetObs.GetFacetId()])
+		if best == nil || score < bestScore {
+			best, bestScore = facetObs, score
+		}
+	}
+	return best.GetObservations()
+}
+
+// nearestDateValue returns the value of the observation with the nearest date to a date within a year,
+// the earlier one in case of ties. Dates that aren't ISO dates only match the same date.
+func nearestDateValue(observations []*pb.PointStat, date string) (float64, bool) {
+	target, targetOk := parseObservationDate(date)
+	var nearest *pb.PointStat
+	var nearestDistance time.Duration
+	for _, obs := range observations {
+		if obs.Value == nil {
+			continue
+		}
+		if obs.GetDate() == date {
+			return obs.GetValue(), true
+		}
+		t, ok := parseObservationDate(obs.GetDate())
+		if !ok || !targetOk || t.Before(target.AddDate(-1, 0, 0)) || t.After(target.AddDate(1, 0, 0)) {
+			continue
+		}
+		distance := t.Sub(target)
+		if distance < 0 {
+			distance = -distance
+		}
+		// Observations are sorted by date, so the earlier one is kept in case of ties.
+		if nearest == nil || distance < nearestDistance {
+			nearest, nearestDistance = obs, distance
+		}
+	}
+	if nearest == nil {
+		return 0, false
+	}
+	return nearest.GetValue(), true
+}
+
+func parseObservationDate(date string) (time.Time, bool) {
+	for _, layout := range denominatorDateLayouts {
+		if len(date) != len(layout) {
+			continue
+		}
+		if t, err := time.Parse(layout, date); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
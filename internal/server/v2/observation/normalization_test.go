@@ -0,0 +1,177 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observation
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// fakeObservationSource serves the same response to every request.
+type fakeObservationSource struct {
+	resp   *pbv2.ObservationResponse
+	gotReq *pbv2.ObservationRequest
+}
+
+func (s *fakeObservationSource) Observation(ctx context.Context, req *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
+	s.gotReq = req
+	return s.resp, nil
+}
+
+// facetObservations returns the observations of a facet with a value for each date.
+func facetObservations(facetID string, values map[string]float64) *pbv2.FacetObservation {
+	facetObs := &pbv2.FacetObservation{FacetId: facetID}
+	for _, date := range sortedKeys(values) {
+		facetObs.Observations = append(facetObs.Observations, &pb.PointStat{Date: date, Value: proto.Float64(values[date])})
+	}
+	facetObs.ObsCount = int32(len(facetObs.Observations))
+	if len(facetObs.Observations) > 0 {
+		facetObs.EarliestDate = facetObs.Observations[0].GetDate()
+		facetObs.LatestDate = facetObs.Observations[len(facetObs.Observations)-1].GetDate()
+	}
+	return facetObs
+}
+
+func TestNormalize(t *testing.T) {
+	facets := map[string]*pb.Facet{
+		"census": {ImportName: "CensusACS5YearSurvey", MeasurementMethod: "CensusACS5yrSurvey"},
+		"pep":    {ImportName: "USCensusPEP_Annual_Population", MeasurementMethod: "CensusPEPSurvey", ObservationPeriod: "P1Y"},
+		"other":  {ImportName: "Other"},
+	}
+	source := &fakeObservationSource{resp: &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{"Count_Person": {ByEntity: map[string]*pbv2.EntityObservation{
+			"geoId/01": {OrderedFacets: []*pbv2.FacetObservation{
+				facetObservations("other", map[string]float64{"2019": 1, "2020": 1}),
+				// PEP is ranked first in ranking.StatsRanking.
+				facetObservations("pep", map[string]float64{"2019": 100, "2020": 200}),
+			}},
+			"geoId/02": {OrderedFacets: []*pbv2.FacetObservation{
+				facetObservations("census", map[string]float64{"2018": 10, "2020": 40}),
+			}},
+			"geoId/03": {OrderedFacets: []*pbv2.FacetObservation{
+				facetObservations("census", map[string]float64{"2019": 0}),
+			}},
+		}}},
+		Facets: facets,
+	}}
+	response := func(byEntity map[string]*pbv2.EntityObservation) *pbv2.ObservationResponse {
+		return &pbv2.ObservationResponse{
+			ByVariable: map[string]*pbv2.VariableObservation{"Count_Farm": {ByEntity: byEntity}},
+			Facets:     map[string]*pb.Facet{"usda": {ImportName: "USDA"}},
+		}
+	}
+	entityObservations := func(values map[string]float64) *pbv2.EntityObservation {
+		return &pbv2.EntityObservation{OrderedFacets: []*pbv2.FacetObservation{facetObservations("usda", values)}}
+	}
+	request := func(value, denominator string) *pbv2.ObservationRequest {
+		return &pbv2.ObservationRequest{
+			Variable:    &pbv2.DcidOrExpression{Dcids: []string{"Count_Farm"}},
+			Entity:      &pbv2.DcidOrExpression{Dcids: []string{"geoId/01", "geoId/02", "geoId/03"}},
+			Value:       value,
+			Select:      []string{"variable", "entity", "date", "value"},
+			Denominator: denominator,
+		}
+	}
+	numerators := func() *pbv2.ObservationResponse {
+		return response(map[string]*pbv2.EntityObservation{
+			"geoId/01": entityObservations(map[string]float64{"2020": 50}),
+			// 2019 ties between 2018 and 2020, and 2022 is over a year from 2020.
+			"geoId/02": entityObservations(map[string]float64{"2019": 5, "2020-06": 8, "2022": 1}),
+			// The denominator is zero.
+			"geoId/03": entityObservations(map[string]float64{"2019": 5}),
+		})
+	}
+
+	for _, tc := range []struct {
+		name string
+		in   *pbv2.ObservationRequest
+		want *pbv2.ObservationResponse
+	}{
+		{
+			name: "no denominator",
+			in:   request("", ""),
+			want: numerators(),
+		},
+		{
+			name: "per capita",
+			in:   request("", "Count_Person"),
+			want: response(map[string]*pbv2.EntityObservation{
+				"geoId/01": entityObservations(map[string]float64{"2020": 0.25}),
+				"geoId/02": entityObservations(map[string]float64{"2019": 0.5, "2020-06": 0.2}),
+				"geoId/03": {},
+			}),
+		},
+		{
+			name: "value filter of ratios",
+			in:   request(">=0.3", "Count_Person"),
+			want: response(map[string]*pbv2.EntityObservation{
+				"geoId/01": {},
+				"geoId/02": entityObservations(map[string]float64{"2019": 0.5}),
+				"geoId/03": {},
+			}),
+		},
+	} {
+		got := numerators()
+		if err := Normalize(context.Background(), source, tc.in, got); err != nil {
+			t.Fatalf("Normalize error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+	if diff := cmp.Diff(source.gotReq.GetEntity().GetDcids(), []string{"geoId/01", "geoId/02", "geoId/03"}); diff != "" {
+		t.Errorf("Unexpected denominator entities diff %v", diff)
+	}
+}
+
+func TestNumeratorRequest(t *testing.T) {
+	in := &pbv2.ObservationRequest{
+		Variable:    &pbv2.DcidOrExpression{Dcids: []string{"Count_Farm"}},
+		Entity:      &pbv2.DcidOrExpression{Expression: "geoId/06<-containedInPlace+{typeOf:County}"},
+		Value:       ">0.1",
+		Select:      []string{"variable", "entity", "date", "value"},
+		Denominator: "Count_Person",
+	}
+	want := &pbv2.ObservationRequest{
+		Variable: &pbv2.DcidOrExpression{Dcids: []string{"Count_Farm"}},
+		Entity:   &pbv2.DcidOrExpression{Expression: "geoId/06<-containedInPlace+{typeOf:County}"},
+		Select:   []string{"variable", "entity", "date", "value"},
+	}
+	got, err := NumeratorRequest(in)
+	if err != nil {
+		t.Fatalf("NumeratorRequest error: %v", err)
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+
+	for _, selects := range [][]string{
+		{"variable", "entity", "facet"},
+		{"variable", "entity", "date"},
+	} {
+		req := &pbv2.ObservationRequest{Select: selects, Denominator: "Count_Person"}
+		if _, err := NumeratorRequest(req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("NumeratorRequest(%v) error = %v, want InvalidArgument", selects, err)
+		}
+	}
+}
@@ -65,52 +65,16 @@ func ObservationCore(
 
 	// Observation date and value query.
 	if queryDate && queryValue {
-		// Series.
-		if len(variable.GetDcids()) > 0 && len(entity.GetDcids()) > 0 {
-			return FetchDirect(
-				ctx,
-				store,
-				cachedata.SQLProvenances(),
-				variable.GetDcids(),
-				entity.GetDcids(),
-				in.GetDate(),
-				in.GetFilter(),
-			)
-		}
-
-		// Collection.
-		if len(variable.GetDcids()) > 0 && entity.GetExpression() != "" {
-			// Example of expression
-			// "geoId/06<-containedInPlace+{typeOf: City}"
-			expr := entity.GetExpression()
-			containedInPlace, err := v2.ParseContainedInPlace(expr)
-			if err != nil {
-				return nil, err
-			}
-			return FetchContainedIn(
-				ctx,
-				store,
-				metadata,
-				cachedata.SQLProvenances(),
-				httpClient,
-				metadata.RemoteMixerDomain,
-				variable.GetDcids(),
-				containedInPlace.Ancestor,
-				containedInPlace.ChildPlaceType,
-				in.GetDate(),
-				in.GetFilter(),
-			)
+		valueFilter, err := util.ParseValueFilter(in.GetValue())
+		if err != nil {
+			return nil, err
 		}
-
-		// Derived series.
-		if variable.GetFormula() != "" && len(entity.GetDcids()) > 0 {
-			return DerivedSeries(
-				ctx,
-				store,
-				variable.GetFormula(),
-				entity.GetDcids(),
-			)
+		resp, err := fetchObservations(ctx, store, cachedata, metadata, httpClient, in)
+		if err != nil {
+			return nil, err
 		}
+		util.FilterObservationsByValue(resp, valueFilter)
+		return resp, nil
 	}
 
 	// Get facet information for <variable, entity> pair.
@@ -163,6 +127,67 @@ func ObservationCore(
 	return &pbv2.ObservationResponse{}, nil
 }
 
+// fetchObservations fetches the observations of a request that selects dates and values.
+func fetchObservations(
+	ctx context.Context,
+	store *store.Store,
+	cachedata *cache.Cache,
+	metadata *resource.Metadata,
+	httpClient *http.Client,
+	in *pbv2.ObservationRequest,
+) (*pbv2.ObservationResponse, error) {
+	variable := in.GetVariable()
+	entity := in.GetEntity()
+
+	// Series.
+	if len(variable.GetDcids()) > 0 && len(entity.GetDcids()) > 0 {
+		return FetchDirect(
+			ctx,
+			store,
+			cachedata.SQLProvenances(),
+			variable.GetDcids(),
+			entity.GetDcids(),
+			in.GetDate(),
+			in.GetFilter(),
+		)
+	}
+
+	// Collection.
+	if len(variable.GetDcids()) > 0 && entity.GetExpression() != "" {
+		// Example of expression
+		// "geoId/06<-containedInPlace+{typeOf: City}"
+		expr := entity.GetExpression()
+		containedInPlace, err := v2.ParseContainedInPlace(expr)
+		if err != nil {
+			return nil, err
+		}
+		return FetchContainedIn(
+			ctx,
+			store,
+			metadata,
+			cachedata.SQLProvenances(),
+			httpClient,
+			metadata.RemoteMixerDomain,
+			variable.GetDcids(),
+			containedInPlace.Ancestor,
+			containedInPlace.ChildPlaceType,
+			in.GetDate(),
+			in.GetFilter(),
+		)
+	}
+
+	// Derived series.
+	if variable.GetFormula() != "" && len(entity.GetDcids()) > 0 {
+		return DerivedSeries(
+			ctx,
+			store,
+			variable.GetFormula(),
+			entity.GetDcids(),
+		)
+	}
+	return &pbv2.ObservationResponse{}, nil
+}
+
 func ObservationInternal(
 	ctx context.Context,
 	store *store.Store,
@@ -171,6 +196,28 @@ func ObservationInternal(
 	httpClient *http.Client,
 	in *pbv2.ObservationRequest,
 ) (*pbv2.ObservationResponse, error) {
+	// Formulas for entity expressions are evaluated from the merged observations of their variables.
+	// Formulas for entity dcids are derived series, see ObservationCore.
+	if in.GetVariable().GetFormula() != "" && in.GetEntity().GetExpression() != "" {
+		source := &v2Source{store: store, cachedata: cachedata, metadata: metadata, httpClient: httpClient}
+		return FormulaObservation(ctx, source, in)
+	}
+	// Date ranges and COMMON_LATEST are applied to the merged response, so that the local and remote
+	// observations agree on the common latest date.
+	dateFilter, err := util.ParseDateFilter(in.GetDate())
+	if err != nil {
+		return nil, err
+	}
+	valueFilter, err := util.ParseValueFilter(in.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	if dateFilter != nil {
+		if in, err = util.ObservationRequestForDateFilter(in); err != nil {
+			return nil, err
+		}
+	}
+
 	errGroup, errCtx := errgroup.WithContext(ctx)
 	localRespChan := make(chan *pbv2.ObservationResponse, 1)
 	remoteRespChan := make(chan *pbv2.ObservationResponse, 1)
@@ -187,10 +234,12 @@ func ObservationInternal(
 	if metadata.RemoteMixerDomain != "" {
 		errGroup.Go(func() error {
 			remoteResp := &pbv2.ObservationResponse{}
-			err := util.FetchRemote(metadata, httpClient, "/v2/observation", in, remoteResp)
+			err := util.FetchRemoteWithContext(errCtx, metadata, httpClient, "/v2/observation", in, remoteResp)
 			if err != nil {
 				return err
 			}
+			// Remote mixers that don't support value filters return all values.
+			util.FilterObservationsByValue(remoteResp, valueFilter)
 			remoteRespChan <- remoteResp
 			return nil
 		})
@@ -206,5 +255,10 @@ func ObservationInternal(
 	localResp, remoteResp := <-localRespChan, <-remoteRespChan
 	// The order of argument matters, localResp is prefered and will be put first
 	// in the merged result.
-	return merger.MergeObservation(localResp, remoteResp), nil
+	resp := merger.MergeObservation(localResp, remoteResp)
+	if dateFilter != nil {
+		util.FilterObservationsByDate(resp, dateFilter)
+		util.FilterObservationsByValue(resp, valueFilter)
+	}
+	return resp, nil
 }
@@ -16,6 +16,7 @@
 package v2
 
 import (
+	"strconv"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -216,3 +217,174 @@ func ParseContainedInPlace(expr string) (*ContainedInPlace, error) {
 	}
 	return &ContainedInPlace{Ancestor: g.Subject, ChildPlaceType: typeOfs[0]}, nil
 }
+
+// ParseResolveProperty parses a resolve property expression into the in arc and out arc.
+// Examples:
+//
+//	<-wikidataId->dcid
+//	<-description{typeOf:City}->dcid
+func ParseResolveProperty(expr string) (*Arc, *Arc, error) {
+	arcs, err := ParseProperty(expr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(arcs) != 2 {
+		return nil, nil, status.Errorf(codes.InvalidArgument,
+			"invalid property for resolving: %s", expr)
+	}
+	inArc, outArc := arcs[0], arcs[1]
+	if inArc.Out || !outArc.Out {
+		return nil, nil, status.Errorf(codes.InvalidArgument,
+			"invalid property for resolving: %s", expr)
+	}
+	return inArc, outArc, nil
+}
+
+// ParseCoordinate parses a coordinate expression of the form "<lat>#<lng>".
+func ParseCoordinate(coordinateExpr string) (float64, float64, error) {
+	parts := strings.Split(coordinateExpr, "#")
+	if len(parts) != 2 {
+		return 0, 0, status.Errorf(codes.InvalidArgument,
+			"invalid coordinate expression: %s", coordinateExpr)
+	}
+
+	lat, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return 0, 0, status.Errorf(codes.InvalidArgument,
+			"invalid coordinate expression: %s", coordinateExpr)
+	}
+
+	lng, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return 0, 0, status.Errorf(codes.InvalidArgument,
+			"invalid coordinate expression: %s", coordinateExpr)
+	}
+
+	return lat, lng, nil
+}
+
+// EventProperty is a parsed event request property.
+type EventProperty struct {
+	EventType string
+	// Date is the month (YYYY-MM) of the events to fetch. It is empty when the
+	// property asks for the dates that have events.
+	Date string
+	// Filter is set when events are filtered by a property value.
+	Filter *EventFilter
+}
+
+// EventFilter keeps events whose property value is within the limits.
+type EventFilter struct {
+	Prop       string
+	Unit       string
+	LowerLimit float64
+	UpperLimit float64
+}
+
+// ParseEventProperty parses an event request property.
+// Examples:
+//
+//	<-location{typeOf:FireEvent, date:2020-10, area:3.1#6.2#Acre}
+//	<-location{typeOf:FireEvent}->date
+func ParseEventProperty(expr string) (*EventProperty, error) {
+	arcs, err := ParseProperty(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	// EventCollection.
+	if len(arcs) == 1 {
+		arc := arcs[0]
+		eventTypes, eventTypesOK := arc.Filter["typeOf"]
+		dates, datesOK := arc.Filter["date"]
+		if arc.Out ||
+			arc.SingleProp != "location" ||
+			(len(arc.Filter) != 2 && len(arc.Filter) != 3) ||
+			!eventTypesOK || len(eventTypes) != 1 ||
+			!datesOK || len(dates) != 1 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid property: %s", expr)
+		}
+		result := &EventProperty{EventType: eventTypes[0], Date: dates[0]}
+		for k, v := range arc.Filter {
+			if k == "typeOf" || k == "date" {
+				continue
+			}
+			if len(v) != 1 {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"invalid event filter in property: %s", expr)
+			}
+			result.Filter, err = ParseEventFilter(k, v[0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+
+	// EventCollectionDate.
+	if len(arcs) == 2 {
+		arc1, arc2 := arcs[0], arcs[1]
+		eventTypes, eventTypesOK := arc1.Filter["typeOf"]
+		if arc1.Out ||
+			arc1.SingleProp != "location" ||
+			!eventTypesOK || len(eventTypes) != 1 ||
+			!arc2.Out ||
+			arc2.SingleProp != "date" {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid property: %s", expr)
+		}
+		return &EventProperty{EventType: eventTypes[0]}, nil
+	}
+
+	return nil, status.Errorf(codes.InvalidArgument, "invalid property: %s", expr)
+}
+
+// ParseEventFilter parses filter for EventCollection.
+// Example: property = "area", filterExpr = "3.1#6.2#Acre".
+func ParseEventFilter(property, filterExpr string) (*EventFilter, error) {
+	if property == "" || filterExpr == "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid event filter: %s, %s", property, filterExpr)
+	}
+
+	parts := strings.Split(filterExpr, "#")
+	if len(parts) != 3 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid event filter: %s", filterExpr)
+	}
+
+	var err error
+	res := &EventFilter{Prop: property, Unit: parts[2]}
+
+	res.LowerLimit, err = strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid event filter lower limit: %s", filterExpr)
+	}
+
+	res.UpperLimit, err = strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid event filter upper limit: %s", filterExpr)
+	}
+
+	return res, nil
+}
+
+// Keep checks whether an event with the given property values meets the filter.
+// Values are expected to be the unit followed by a number, e.g. "Acre 5.2".
+func (f *EventFilter) Keep(propVals map[string][]string) bool {
+	if f == nil || f.Prop == "" {
+		return true
+	}
+	vals, ok := propVals[f.Prop]
+	if !ok || len(vals) == 0 {
+		return false
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimPrefix(vals[0], f.Unit)), 64)
+	if err != nil {
+		return false
+	}
+	return v >= f.LowerLimit && v <= f.UpperLimit
+}
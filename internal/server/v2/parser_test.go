@@ -316,6 +316,37 @@ func TestParseProperty(t *testing.T) {
 	}
 }
 
+func TestArcString(t *testing.T) {
+	for _, c := range []struct {
+		expr string
+		want string
+	}{
+		{"<-", "<-"},
+		{"->*", "->*"},
+		{"-> [name, typeOf]", "->[name,typeOf]"},
+		{"<-containedInPlace+{typeOf: County}", "<-containedInPlace+{typeOf:County}"},
+		{"<-observationAbout{variableMeasured:Count_Person, typeOf:[A, B]}", "<-observationAbout{typeOf:[A,B],variableMeasured:Count_Person}"},
+	} {
+		arcs, err := ParseProperty(c.expr)
+		if err != nil {
+			t.Errorf("ParseProperty(%s) got error %v", c.expr, err)
+			continue
+		}
+		if got := arcs[0].String(); got != c.want {
+			t.Errorf("String(%s) = %s, want %s", c.expr, got, c.want)
+		}
+		// The expression parses to the same arc.
+		reparsed, err := ParseProperty(c.want)
+		if err != nil {
+			t.Errorf("ParseProperty(%s) got error %v", c.want, err)
+			continue
+		}
+		if diff := cmp.Diff(reparsed, arcs); diff != "" {
+			t.Errorf("ParseProperty(%s) got diff %v", c.want, diff)
+		}
+	}
+}
+
 func TestParseLinkedNodes(t *testing.T) {
 	for _, c := range []struct {
 		expr  string
@@ -384,3 +415,128 @@ func TestGetContainedInPlace(t *testing.T) {
 		}
 	}
 }
+
+func TestParseCoordinate(t *testing.T) {
+	for _, c := range []struct {
+		coordinateExpr string
+		wantLat        float64
+		wantLng        float64
+		wantErr        bool
+	}{
+		{"1.2#3.4", 1.2, 3.4, false},
+		{"-1.2#abc", 0, 0, true},
+		{"1.2,3.4", 0, 0, true},
+	} {
+		gotLat, gotLng, err := ParseCoordinate(c.coordinateExpr)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("ParseCoordinate(%s) got no error, want error",
+					c.coordinateExpr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseCoordinate(%s) = %s", c.coordinateExpr, err)
+			continue
+		}
+		if gotLat != c.wantLat || gotLng != c.wantLng {
+			t.Errorf("ParseCoordinate(%s) = %f, %f, want %f, %f",
+				c.coordinateExpr, gotLat, gotLng, c.wantLat, c.wantLng)
+		}
+	}
+}
+
+func TestParseEventProperty(t *testing.T) {
+	for _, c := range []struct {
+		expr    string
+		want    *EventProperty
+		wantErr bool
+	}{
+		{
+			"<-location{typeOf:FireEvent, date:2020-10}",
+			&EventProperty{EventType: "FireEvent", Date: "2020-10"},
+			false,
+		},
+		{
+			"<-location{typeOf:FireEvent, date:2020-10, area:3.1#6.2#Acre}",
+			&EventProperty{
+				EventType: "FireEvent",
+				Date:      "2020-10",
+				Filter:    &EventFilter{Prop: "area", Unit: "Acre", LowerLimit: 3.1, UpperLimit: 6.2},
+			},
+			false,
+		},
+		{
+			"<-location{typeOf:FireEvent}->date",
+			&EventProperty{EventType: "FireEvent"},
+			false,
+		},
+		// Missing date.
+		{"<-location{typeOf:FireEvent}", nil, true},
+		// Out arc.
+		{"->location{typeOf:FireEvent, date:2020-10}", nil, true},
+		// Not location.
+		{"<-affectedPlace{typeOf:FireEvent, date:2020-10}", nil, true},
+		// Invalid filter.
+		{"<-location{typeOf:FireEvent, date:2020-10, area:3.1#Acre}", nil, true},
+		// Second arc is not date.
+		{"<-location{typeOf:FireEvent}->name", nil, true},
+		{"<-location{typeOf:FireEvent}->date->name", nil, true},
+	} {
+		got, err := ParseEventProperty(c.expr)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("ParseEventProperty(%s) got no error, want error", c.expr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseEventProperty(%s) = %s", c.expr, err)
+			continue
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("ParseEventProperty(%s) got diff %v", c.expr, diff)
+		}
+	}
+}
+
+func TestParseEventFilter(t *testing.T) {
+	for _, c := range []struct {
+		property   string
+		filterExpr string
+		want       *EventFilter
+		wantErr    bool
+	}{
+		{
+			"area", "3.1#6.2#Acre",
+			&EventFilter{Prop: "area", Unit: "Acre", LowerLimit: 3.1, UpperLimit: 6.2},
+			false,
+		},
+		{
+			"magnitude", "-1#5#",
+			&EventFilter{Prop: "magnitude", LowerLimit: -1, UpperLimit: 5},
+			false,
+		},
+		{"", "3.1#6.2#Acre", nil, true},
+		{"area", "", nil, true},
+		{"area", "3.1#6.2", nil, true},
+		{"area", "abc#6.2#Acre", nil, true},
+		{"area", "3.1#abc#Acre", nil, true},
+	} {
+		got, err := ParseEventFilter(c.property, c.filterExpr)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("ParseEventFilter(%s, %s) got no error, want error",
+					c.property, c.filterExpr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseEventFilter(%s, %s) = %s", c.property, c.filterExpr, err)
+			continue
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("ParseEventFilter(%s, %s) got diff %v", c.property, c.filterExpr, diff)
+		}
+	}
+}
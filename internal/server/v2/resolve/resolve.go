@@ -18,34 +18,15 @@ package resolve
 import (
 	"context"
 	"sort"
-	"strconv"
-	"strings"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
 	"github.com/datacommonsorg/mixer/internal/server/recon"
+	v2 "github.com/datacommonsorg/mixer/internal/server/v2"
 	"github.com/datacommonsorg/mixer/internal/store"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"googlemaps.github.io/maps"
 )
 
-var resolvedPlaceTypePriorityList = []string{
-	"AdministrativeArea5",
-	"AdministrativeArea4",
-	"AdministrativeArea3",
-	"AdministrativeArea2",
-	"AdministrativeArea1",
-	"EurostatNUTS3",
-	"EurostatNUTS2",
-	"EurostatNUTS1",
-	"Town",
-	"City",
-	"County",
-	"State",
-	"Country",
-}
-
 // ID resolves ID to ID.
 func ID(
 	ctx context.Context,
@@ -97,7 +78,7 @@ func Coordinate(
 	coordinates := []*pb.ResolveCoordinatesRequest_Coordinate{}
 	latLngToNode := map[latLng]string{}
 	for _, node := range nodes {
-		lat, lng, err := parseCoordinate(node)
+		lat, lng, err := v2.ParseCoordinate(node)
 		if err != nil {
 			return nil, err
 		}
@@ -177,28 +158,6 @@ func Description(
 	return resp, nil
 }
 
-func parseCoordinate(coordinateExpr string) (float64, float64, error) {
-	parts := strings.Split(coordinateExpr, "#")
-	if len(parts) != 2 {
-		return 0, 0, status.Errorf(codes.InvalidArgument,
-			"invalid coordinate expression: %s", coordinateExpr)
-	}
-
-	lat, err := strconv.ParseFloat(parts[0], 64)
-	if err != nil {
-		return 0, 0, status.Errorf(codes.InvalidArgument,
-			"invalid coordinate expression: %s", coordinateExpr)
-	}
-
-	lng, err := strconv.ParseFloat(parts[1], 64)
-	if err != nil {
-		return 0, 0, status.Errorf(codes.InvalidArgument,
-			"invalid coordinate expression: %s", coordinateExpr)
-	}
-
-	return lat, lng, nil
-}
-
 // Sort resolved place candidates by a priority list of place types.
 // If a candidate's type is not in the priority list, then sort by DCID alphabetically.
 func getSortedResolvedPlaceCandidates(
@@ -216,7 +175,7 @@ func getSortedResolvedPlaceCandidates(
 	// Add candidates whose type is in the priority list.
 	candidates := []*pbv2.ResolveResponse_Entity_Candidate{}
 	selectedPriorityTypeSet := map[string]struct{}{}
-	for _, priorityType := range resolvedPlaceTypePriorityList {
+	for _, priorityType := range v2.ResolvedPlaceTypePriorityList {
 		if candidate, ok := typeToCandidate[priorityType]; ok {
 			candidates = append(candidates, candidate)
 			selectedPriorityTypeSet[priorityType] = struct{}{}
@@ -45,13 +45,14 @@ var (
 )
 
 func fetchRemoteWrapper(
+	ctx context.Context,
 	metadata *resource.Metadata,
 	httpClient *http.Client,
 	apiPath string,
 	remoteReq *pbv2.NodeRequest,
 ) (*pbv2.NodeResponse, error) {
 	remoteResp := &pbv2.NodeResponse{}
-	err := util.FetchRemote(metadata, httpClient, apiPath, remoteReq, remoteResp)
+	err := util.FetchRemoteWithContext(ctx, metadata, httpClient, apiPath, remoteReq, remoteResp)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +68,7 @@ func storeFetchChildPlaces(
 }
 
 func remoteMixerFetchChildPlaces(
+	ctx context.Context,
 	metadata *resource.Metadata,
 	httpClient *http.Client,
 	ancestor, childType string,
@@ -75,7 +77,7 @@ func remoteMixerFetchChildPlaces(
 		Nodes:    []string{ancestor},
 		Property: fmt.Sprintf("<-containedInPlace+{typeOf:%s}", childType),
 	}
-	return fetchRemote(metadata, httpClient, "/v2/node", remoteReq)
+	return fetchRemote(ctx, metadata, httpClient, "/v2/node", remoteReq)
 }
 
 // FetchChildPlaces fetches child places
@@ -102,7 +104,7 @@ func FetchChildPlaces(
 
 	if remoteMixer != "" {
 		errGroup.Go(func() error {
-			remoteMixerResponse, err := remoteMixerFetchChildPlaces(metadata, httpClient, ancestor, childType)
+			remoteMixerResponse, err := remoteMixerFetchChildPlaces(errCtx, metadata, httpClient, ancestor, childType)
 			if err != nil {
 				return err
 			}
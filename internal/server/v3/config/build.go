@@ -0,0 +1,244 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/datacommonsorg/mixer/internal/merger"
+	"github.com/datacommonsorg/mixer/internal/server/cache"
+	"github.com/datacommonsorg/mixer/internal/server/datasource"
+	"github.com/datacommonsorg/mixer/internal/server/datasources"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
+	"github.com/datacommonsorg/mixer/internal/server/lru"
+	"github.com/datacommonsorg/mixer/internal/server/mock"
+	"github.com/datacommonsorg/mixer/internal/server/redis"
+	"github.com/datacommonsorg/mixer/internal/server/remote"
+	"github.com/datacommonsorg/mixer/internal/server/resource"
+	"github.com/datacommonsorg/mixer/internal/server/singleflight"
+	"github.com/datacommonsorg/mixer/internal/server/spanner"
+	"github.com/datacommonsorg/mixer/internal/server/v3/observation"
+	"github.com/datacommonsorg/mixer/internal/sqldb"
+	"github.com/datacommonsorg/mixer/internal/util"
+)
+
+// Dependencies holds the parts of the server that the V3 data sources and processors are built on.
+type Dependencies struct {
+	// Metadata of the server, used by remote sources.
+	Metadata *resource.Metadata
+	// SQL database of the server, used by sql sources that don't set a database.
+	SQLClient *sqldb.SQLClient
+	// Cache of the server, used by calculation processors.
+	// Can be nil.
+	Cache *cache.Cache
+}
+
+// namedDataSource overrides the id of a data source.
+type namedDataSource struct {
+	datasource.DataSource
+	id string
+}
+
+func (ds *namedDataSource) Id() string {
+	return ds.id
+}
+
+func (ds *namedDataSource) Reload(ctx context.Context) error {
+	if reloader, ok := ds.DataSource.(datasource.Reloader); ok {
+		return reloader.Reload(ctx)
+	}
+	return nil
+}
+
+func (ds *namedDataSource) Existence() map[util.EntityVariable]struct{} {
+	if existence, ok := ds.DataSource.(datasource.ObservationExistence); ok {
+		return existence.Existence()
+	}
+	return nil
+}
+
+// NewDispatcher builds the data sources and processors of a config into a dispatcher.
+// The returned function releases the connections opened for the dispatcher.
+func NewDispatcher(ctx context.Context, cfg *Config, deps *Dependencies) (*dispatcher.Dispatcher, func(), error) {
+	closers := []func() error{}
+	closeAll := func() {
+		for _, closer := range closers {
+			if err := closer(); err != nil {
+				log.Printf("Error closing V3 connection: %v", err)
+			}
+		}
+	}
+
+	dataSources, err := newDataSources(ctx, cfg, deps, &closers)
+	if err != nil {
+		closeAll()
+		return nil, nil, err
+	}
+
+	processors := []*dispatcher.Processor{}
+	for i, processorCfg := range cfg.Processors {
+		var processor dispatcher.Processor
+		switch processorCfg.Type {
+		case ProcessorCache:
+			redisClient, err := redis.NewCacheClientFromConfig(processorCfg.Redis)
+			if err != nil {
+				closeAll()
+				return nil, nil, fmt.Errorf("processors[%d] (%s): %w", i, processorCfg.Type, err)
+			}
+			closers = append(closers, redisClient.Close)
+			processor = redis.NewCacheProcessor(redisClient)
+		case ProcessorLRUCache:
+			processor = lru.NewCacheProcessor(processorCfg.LRU)
+		case ProcessorCalculation:
+			var svFormulas map[string][]string
+			if deps.Cache != nil {
+				svFormulas = deps.Cache.SVFormula()
+			}
+			processor = observation.NewCalculationProcessor(dataSources, svFormulas, processorCfg.Calculation)
+		case ProcessorAggregation:
+			processor = observation.NewAggregationProcessor(dataSources)
+		case ProcessorNormalization:
+			processor = observation.NewNormalizationProcessor(dataSources)
+		case ProcessorSingleflight:
+			processor = singleflight.NewCoalescingProcessor(processorCfg.Singleflight)
+		}
+		processors = append(processors, &processor)
+	}
+
+	return dispatcher.NewDispatcher(processors, dataSources), closeAll, nil
+}
+
+// newDataSources builds the data sources of a config. The functions that release their connections are added to closers.
+func newDataSources(ctx context.Context, cfg *Config, deps *Dependencies, closers *[]func() error) (*datasources.DataSources, error) {
+	// Remote sources are created first since sql sources use the first one for data that is not in SQL.
+	remoteSources := map[int]datasource.DataSource{}
+	var secondarySource datasource.DataSource
+	for i, sourceCfg := range cfg.Sources {
+		if sourceCfg.Type != datasource.TypeRemote {
+			continue
+		}
+		remoteClient, err := remote.NewRemoteClientFromConfig(deps.Metadata, sourceCfg.Remote)
+		if err != nil {
+			return nil, fmt.Errorf("sources[%d] (%s): %w", i, sourceCfg.Type, err)
+		}
+		*closers = append(*closers, remoteClient.Close)
+		remoteSources[i] = remote.NewRemoteDataSource(remoteClient)
+		if secondarySource == nil {
+			secondarySource = remoteSources[i]
+		}
+	}
+
+	sources := []*datasource.DataSource{}
+	policies := map[string]*datasources.SourcePolicy{}
+	routing := &datasources.Routing{Prefixes: map[string][]string{}}
+	for i, sourceCfg := range cfg.Sources {
+		var source datasource.DataSource
+		switch sourceCfg.Type {
+		case datasource.TypeSpanner:
+			spannerClient, err := spanner.NewSpannerClientFromConfig(ctx, sourceCfg.Spanner)
+			if err != nil {
+				return nil, fmt.Errorf("sources[%d] (%s): %w", i, sourceCfg.Type, err)
+			}
+			source = spanner.NewSpannerDataSource(spannerClient)
+		case datasource.TypeSQL:
+			sqlClient, err := newSQLClient(sourceCfg, deps, closers)
+			if err != nil {
+				return nil, fmt.Errorf("sources[%d] (%s): %w", i, sourceCfg.Type, err)
+			}
+			sqlSource, err := sqldb.NewSQLDataSource(sqlClient, secondarySource)
+			if err != nil {
+				return nil, fmt.Errorf("sources[%d] (%s): %w", i, sourceCfg.Type, err)
+			}
+			source = sqlSource
+		case datasource.TypeRemote:
+			source = remoteSources[i]
+		case datasource.TypeMock:
+			graph, err := mock.LoadGraph(sourceCfg.Paths)
+			if err != nil {
+				return nil, fmt.Errorf("sources[%d] (%s): %w", i, sourceCfg.Type, err)
+			}
+			source = mock.NewMockDataSource("local", graph)
+		default:
+			return nil, fmt.Errorf("sources[%d]: unknown source type %s", i, sourceCfg.Type)
+		}
+
+		source = withId(source, sourceCfg.Id)
+		id := source.Id()
+		if _, ok := policies[id]; ok {
+			return nil, fmt.Errorf("sources[%d] (%s): duplicate id %s, set a unique id", i, sourceCfg.Type, id)
+		}
+		sources = append(sources, &source)
+		if policy, ok := cfg.SourcePolicies[id]; ok {
+			policies[id] = policy
+		} else {
+			policies[id] = sourceCfg.policy()
+		}
+		if prefixes, ok := cfg.SourcePrefixes[id]; ok {
+			routing.Prefixes[id] = prefixes
+		} else if len(sourceCfg.Prefixes) > 0 {
+			routing.Prefixes[id] = sourceCfg.Prefixes
+		}
+	}
+
+	mergePolicy, err := merger.ParseMergePolicy(cfg.MergePolicy)
+	if err != nil {
+		return nil, err
+	}
+	return datasources.NewDataSources(sources, policies, mergePolicy, routing), nil
+}
+
+// newSQLClient returns the client of the database of a sql source, opening it if the source sets one.
+// Otherwise the source uses the SQL database of the server.
+func newSQLClient(sourceCfg *SourceConfig, deps *Dependencies, closers *[]func() error) (*sqldb.SQLClient, error) {
+	var client *sqldb.SQLClient
+	var err error
+	switch {
+	case sourceCfg.SQLitePath != "":
+		client, err = sqldb.NewSQLiteClient(sourceCfg.SQLitePath)
+	case sourceCfg.CloudSQLInstance != "":
+		client, err = sqldb.NewCloudSQLClient(sourceCfg.CloudSQLInstance)
+	default:
+		if deps.SQLClient == nil || !sqldb.IsConnected(deps.SQLClient) {
+			return nil, fmt.Errorf("no SQL database is connected")
+		}
+		return deps.SQLClient, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	*closers = append(*closers, client.Close)
+	if err := client.ValidateDatabase(); err != nil {
+		return nil, fmt.Errorf("SQL database validation failed: %w", err)
+	}
+	return client, nil
+}
+
+// withId overrides the id of a data source if one is set.
+func withId(source datasource.DataSource, id string) datasource.DataSource {
+	if id == "" {
+		return source
+	}
+	return &namedDataSource{DataSource: source, id: id}
+}
+
+func (source *SourceConfig) policy() *datasources.SourcePolicy {
+	return &datasources.SourcePolicy{
+		Required: !source.BestEffort,
+		Timeout:  source.Timeout,
+		Priority: source.Priority,
+	}
+}
@@ -0,0 +1,356 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package config defines the declarative configuration of the V3 data sources and processors.
+//
+// Example:
+//
+//	merge_policy: first_non_empty
+//	sources:
+//	  - type: sql
+//	    priority: 10
+//	    prefixes: [myorg/]
+//	    sqlite_path: /data/datacommons.db
+//	  - type: remote
+//	    best_effort: true
+//	    timeout: 2s
+//	    remote:
+//	      transport: grpc
+//	      grpc_address: mixer.example.org:443
+//	      timeout: 1s
+//	      max_attempts: 2
+//	processors:
+//	  - type: singleflight
+//	    singleflight:
+//	      timeout: 30s
+//	  - type: lru_cache
+//	    lru:
+//	      max_bytes: 268435456
+//	      ttl: 1h
+//	      ttls:
+//	        Observation: 10m
+//	  - type: cache
+//	    redis:
+//	      instances:
+//	        - region: us-central1
+//	          host: 10.0.0.1
+//	          port: "6379"
+//	  - type: calculation
+//	    calculation:
+//	      timeout: 5s
+package config
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/datacommonsorg/mixer/internal/merger"
+	"github.com/datacommonsorg/mixer/internal/server/datasource"
+	"github.com/datacommonsorg/mixer/internal/server/datasources"
+	"github.com/datacommonsorg/mixer/internal/server/lru"
+	"github.com/datacommonsorg/mixer/internal/server/redis"
+	"github.com/datacommonsorg/mixer/internal/server/remote"
+	"github.com/datacommonsorg/mixer/internal/server/singleflight"
+	"github.com/datacommonsorg/mixer/internal/server/spanner"
+	"github.com/datacommonsorg/mixer/internal/server/v3/observation"
+	"gopkg.in/yaml.v3"
+)
+
+// ProcessorType represents the type of processor.
+type ProcessorType string
+
+const (
+	// ProcessorCache caches responses in Redis.
+	ProcessorCache ProcessorType = "cache"
+	// ProcessorLRUCache caches responses in memory.
+	ProcessorLRUCache ProcessorType = "lru_cache"
+	// ProcessorCalculation calculates missing observations from formulas.
+	ProcessorCalculation ProcessorType = "calculation"
+	// ProcessorAggregation aggregates missing observations from child places, for requests that select an aggregation.
+	ProcessorAggregation ProcessorType = "aggregation"
+	// ProcessorNormalization divides observations by a denominator variable, for requests that have one.
+	ProcessorNormalization ProcessorType = "normalization"
+	// ProcessorSingleflight coalesces identical concurrent requests.
+	ProcessorSingleflight ProcessorType = "singleflight"
+)
+
+var (
+	sourceTypes    = []datasource.DataSourceType{datasource.TypeSpanner, datasource.TypeSQL, datasource.TypeRemote, datasource.TypeMock}
+	processorTypes = []ProcessorType{ProcessorCache, ProcessorLRUCache, ProcessorCalculation, ProcessorAggregation, ProcessorNormalization, ProcessorSingleflight}
+)
+
+// Config is the configuration of the V3 data sources and processors.
+type Config struct {
+	// Policy used to merge the responses of the sources, see merger.ParseMergePolicy.
+	MergePolicy string `yaml:"merge_policy"`
+	// Data sources, in order of preference for sources with the same priority.
+	Sources []*SourceConfig `yaml:"sources"`
+	// Processors, in the order in which they pre-process requests.
+	Processors []*ProcessorConfig `yaml:"processors"`
+	// Policies keyed by source id, set from the V3 flags.
+	// They replace the policy settings of the sources with these ids.
+	SourcePolicies map[string]*datasources.SourcePolicy `yaml:"-"`
+	// DCID prefixes keyed by source id, set from the V3 flags.
+	// They replace the prefixes of the sources with these ids.
+	SourcePrefixes map[string][]string `yaml:"-"`
+}
+
+// SourceConfig is the configuration of a data source.
+type SourceConfig struct {
+	Type datasource.DataSourceType `yaml:"type"`
+	// Id overrides the id of the source, e.g. in the failed sources trailer.
+	// Sources must have unique ids, so it is required for sources of the same type that would share one,
+	// e.g. remote sources of the same server domain.
+	Id string `yaml:"id"`
+	// Sources with a higher priority are preferred when merging responses.
+	Priority int `yaml:"priority"`
+	// Timeout for each call to the source, e.g. 500ms. No timeout is applied if not set.
+	Timeout time.Duration `yaml:"timeout"`
+	// Failures of best-effort sources don't fail requests.
+	BestEffort bool `yaml:"best_effort"`
+	// DCID prefixes that are only routed to this source.
+	Prefixes []string `yaml:"prefixes"`
+
+	// Connection of spanner sources.
+	Spanner *spanner.SpannerConfig `yaml:"spanner"`
+	// Database of sql sources, at most one can be set.
+	// If none is set, the sql source uses the SQL database of the server.
+	SQLitePath       string `yaml:"sqlite_path"`
+	CloudSQLInstance string `yaml:"cloudsql_instance"`
+	// MCF and CSV files of mock sources.
+	Paths []string `yaml:"paths"`
+	// Timeouts, retries and circuit breaking of the calls of remote sources.
+	// Timeout above bounds a whole call, including retries.
+	Remote *remote.Config `yaml:"remote"`
+}
+
+// ProcessorConfig is the configuration of a processor.
+type ProcessorConfig struct {
+	Type ProcessorType `yaml:"type"`
+	// Redis instances of cache processors.
+	Redis *redis.RedisConfig `yaml:"redis"`
+	// Size and TTLs of lru_cache processors.
+	LRU *lru.Config `yaml:"lru"`
+	// Timeout of singleflight processors, optional.
+	Singleflight *singleflight.Config `yaml:"singleflight"`
+	// Timeout and concurrency of calculation processors, optional.
+	Calculation *observation.CalculationConfig `yaml:"calculation"`
+}
+
+// Load reads and validates the config from a YAML file.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading V3 config %s: %w", path, err)
+	}
+	cfg, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("error in V3 config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Parse parses and validates the config from YAML.
+// Unknown fields are rejected to catch typos.
+func Parse(data []byte) (*Config, error) {
+	cfg := &Config{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Hash returns a hash of the config, used to version cached responses.
+func (cfg *Config) Hash() (string, error) {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling V3 config: %w", err)
+	}
+	// Source policies and prefixes set from flags change responses too.
+	if len(cfg.SourcePolicies) > 0 || len(cfg.SourcePrefixes) > 0 {
+		flagData, err := yaml.Marshal([]interface{}{cfg.SourcePolicies, cfg.SourcePrefixes})
+		if err != nil {
+			return "", fmt.Errorf("error marshaling V3 source settings: %w", err)
+		}
+		data = append(data, flagData...)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Validate returns an error describing the first invalid setting of the config.
+func (cfg *Config) Validate() error {
+	if _, err := merger.ParseMergePolicy(cfg.MergePolicy); err != nil {
+		return fmt.Errorf("merge_policy: %w", err)
+	}
+	if len(cfg.Sources) == 0 {
+		return fmt.Errorf("sources: at least one source is required")
+	}
+
+	seenIds := map[string]struct{}{}
+	for i, source := range cfg.Sources {
+		if source == nil {
+			return fmt.Errorf("sources[%d]: empty source", i)
+		}
+		if err := source.validate(); err != nil {
+			return fmt.Errorf("sources[%d] (%s): %w", i, source.Type, err)
+		}
+		// Policies and routing are per source id. Ids of sources without one are checked when they are built.
+		if source.Id == "" {
+			continue
+		}
+		if _, ok := seenIds[source.Id]; ok {
+			return fmt.Errorf("sources[%d] (%s): duplicate id %s", i, source.Type, source.Id)
+		}
+		seenIds[source.Id] = struct{}{}
+	}
+
+	seenProcessors := map[ProcessorType]struct{}{}
+	for i, processor := range cfg.Processors {
+		if processor == nil {
+			return fmt.Errorf("processors[%d]: empty processor", i)
+		}
+		if err := processor.validate(); err != nil {
+			return fmt.Errorf("processors[%d] (%s): %w", i, processor.Type, err)
+		}
+		if _, ok := seenProcessors[processor.Type]; ok {
+			return fmt.Errorf("processors[%d] (%s): only one processor of each type can be configured", i, processor.Type)
+		}
+		seenProcessors[processor.Type] = struct{}{}
+	}
+	return nil
+}
+
+func (source *SourceConfig) validate() error {
+	if source.Timeout < 0 {
+		return fmt.Errorf("timeout must not be negative")
+	}
+	for _, prefix := range source.Prefixes {
+		if strings.TrimSpace(prefix) == "" {
+			return fmt.Errorf("prefixes must not be empty")
+		}
+	}
+
+	switch source.Type {
+	case datasource.TypeSpanner:
+		if source.Spanner == nil || source.Spanner.Project == "" || source.Spanner.Instance == "" || source.Spanner.Database == "" {
+			return fmt.Errorf("spanner.project, spanner.instance and spanner.database are required")
+		}
+	case datasource.TypeSQL:
+		if source.SQLitePath != "" && source.CloudSQLInstance != "" {
+			return fmt.Errorf("only one of sqlite_path and cloudsql_instance can be set")
+		}
+	case datasource.TypeRemote:
+		if source.Remote != nil {
+			if err := validateRemote(source.Remote); err != nil {
+				return err
+			}
+		}
+	case datasource.TypeMock:
+		if len(source.Paths) == 0 {
+			return fmt.Errorf("paths are required")
+		}
+	default:
+		return fmt.Errorf("unknown source type, want one of %v", sourceTypes)
+	}
+
+	if source.Spanner != nil && source.Type != datasource.TypeSpanner {
+		return fmt.Errorf("spanner is only supported for spanner sources")
+	}
+	if (source.SQLitePath != "" || source.CloudSQLInstance != "") && source.Type != datasource.TypeSQL {
+		return fmt.Errorf("sqlite_path and cloudsql_instance are only supported for sql sources")
+	}
+	if len(source.Paths) > 0 && source.Type != datasource.TypeMock {
+		return fmt.Errorf("paths are only supported for mock sources")
+	}
+	if source.Remote != nil && source.Type != datasource.TypeRemote {
+		return fmt.Errorf("remote is only supported for remote sources")
+	}
+	return nil
+}
+
+func validateRemote(cfg *remote.Config) error {
+	if cfg.Timeout < 0 || cfg.InitialBackoff < 0 || cfg.MaxBackoff < 0 || cfg.BreakerCooldown < 0 {
+		return fmt.Errorf("remote durations must not be negative")
+	}
+	if cfg.MaxAttempts < 0 {
+		return fmt.Errorf("remote.max_attempts must not be negative")
+	}
+	switch cfg.Transport {
+	case "", remote.TransportHTTP:
+		if cfg.GRPCAddress != "" || cfg.GRPCInsecure {
+			return fmt.Errorf("remote.grpc_address and remote.grpc_insecure are only supported for the grpc transport")
+		}
+	case remote.TransportGRPC:
+		if cfg.GRPCAddress == "" {
+			return fmt.Errorf("remote.grpc_address is required for the grpc transport")
+		}
+	default:
+		return fmt.Errorf("unknown remote.transport, want one of %v", []remote.Transport{remote.TransportHTTP, remote.TransportGRPC})
+	}
+	return nil
+}
+
+func (processor *ProcessorConfig) validate() error {
+	switch processor.Type {
+	case ProcessorCache:
+		if processor.Redis == nil || len(processor.Redis.Instances) == 0 {
+			return fmt.Errorf("redis.instances are required")
+		}
+		for i, instance := range processor.Redis.Instances {
+			if instance.Host == "" || instance.Port == "" {
+				return fmt.Errorf("redis.instances[%d]: host and port are required", i)
+			}
+		}
+	case ProcessorLRUCache:
+		if processor.LRU == nil || processor.LRU.MaxBytes <= 0 {
+			return fmt.Errorf("lru.max_bytes must be positive")
+		}
+	case ProcessorCalculation:
+		if processor.Calculation != nil && (processor.Calculation.Timeout < 0 || processor.Calculation.Concurrency < 0) {
+			return fmt.Errorf("calculation.timeout and calculation.concurrency must not be negative")
+		}
+	case ProcessorAggregation, ProcessorNormalization:
+	case ProcessorSingleflight:
+		if processor.Singleflight != nil && processor.Singleflight.Timeout < 0 {
+			return fmt.Errorf("singleflight.timeout must not be negative")
+		}
+	default:
+		return fmt.Errorf("unknown processor type, want one of %v", processorTypes)
+	}
+
+	if processor.Redis != nil && processor.Type != ProcessorCache {
+		return fmt.Errorf("redis is only supported for cache processors")
+	}
+	if processor.LRU != nil && processor.Type != ProcessorLRUCache {
+		return fmt.Errorf("lru is only supported for lru_cache processors")
+	}
+	if processor.Singleflight != nil && processor.Type != ProcessorSingleflight {
+		return fmt.Errorf("singleflight is only supported for singleflight processors")
+	}
+	if processor.Calculation != nil && processor.Type != ProcessorCalculation {
+		return fmt.Errorf("calculation is only supported for calculation processors")
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/datasource"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
+	"github.com/datacommonsorg/mixer/internal/server/lru"
+	"github.com/datacommonsorg/mixer/internal/server/redis"
+	"github.com/datacommonsorg/mixer/internal/server/remote"
+	"github.com/datacommonsorg/mixer/internal/server/singleflight"
+	"github.com/datacommonsorg/mixer/internal/server/spanner"
+	"github.com/datacommonsorg/mixer/internal/server/v3/observation"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		yaml    string
+		want    *Config
+		wantErr string
+	}{
+		{
+			name: "valid",
+			yaml: `
+merge_policy: first_non_empty
+sources:
+  - type: spanner
+    spanner:
+      project: p
+      instance: i
+      database: d
+  - type: sql
+    id: custom
+    priority: 10
+    prefixes: [myorg/]
+    sqlite_path: /data/datacommons.db
+  - type: remote
+    best_effort: true
+    timeout: 2s
+    remote:
+      transport: grpc
+      grpc_address: localhost:12345
+      grpc_insecure: true
+      timeout: 1s
+      max_attempts: 2
+      breaker_threshold: -1
+processors:
+  - type: singleflight
+    singleflight:
+      timeout: 30s
+  - type: lru_cache
+    lru:
+      max_bytes: 1024
+      ttl: 1h
+      ttls:
+        Observation: 10m
+  - type: cache
+    redis:
+      instances:
+        - region: us-central1
+          host: 10.0.0.1
+          port: "6379"
+  - type: normalization
+  - type: aggregation
+  - type: calculation
+    calculation:
+      timeout: 5s
+      concurrency: 4
+`,
+			want: &Config{
+				MergePolicy: "first_non_empty",
+				Sources: []*SourceConfig{
+					{Type: datasource.TypeSpanner, Spanner: &spanner.SpannerConfig{Project: "p", Instance: "i", Database: "d"}},
+					{Type: datasource.TypeSQL, Id: "custom", Priority: 10, Prefixes: []string{"myorg/"}, SQLitePath: "/data/datacommons.db"},
+					{
+						Type:       datasource.TypeRemote,
+						BestEffort: true,
+						Timeout:    2 * time.Second,
+						Remote: &remote.Config{
+							Transport:        remote.TransportGRPC,
+							GRPCAddress:      "localhost:12345",
+							GRPCInsecure:     true,
+							Timeout:          time.Second,
+							MaxAttempts:      2,
+							BreakerThreshold: -1,
+						},
+					},
+				},
+				Processors: []*ProcessorConfig{
+					{Type: ProcessorSingleflight, Singleflight: &singleflight.Config{Timeout: 30 * time.Second}},
+					{
+						Type: ProcessorLRUCache,
+						LRU: &lru.Config{
+							MaxBytes: 1024,
+							TTL:      time.Hour,
+							TTLs:     map[dispatcher.RequestType]time.Duration{dispatcher.TypeObservation: 10 * time.Minute},
+						},
+					},
+					{
+						Type: ProcessorCache,
+						Redis: &redis.RedisConfig{Instances: []redis.RedisInstanceConfig{
+							{Region: "us-central1", Host: "10.0.0.1", Port: "6379"},
+						}},
+					},
+					{Type: ProcessorNormalization},
+					{Type: ProcessorAggregation},
+					{Type: ProcessorCalculation, Calculation: &observation.CalculationConfig{Timeout: 5 * time.Second, Concurrency: 4}},
+				},
+			},
+		},
+		{
+			name:    "unknown field",
+			yaml:    "sources:\n  - type: remote\n    timout: 2s\n",
+			wantErr: "field timout not found",
+		},
+		{
+			name:    "no sources",
+			yaml:    "processors:\n  - type: calculation\n",
+			wantErr: "sources: at least one source is required",
+		},
+		{
+			name:    "unknown source type",
+			yaml:    "sources:\n  - type: bigtable\n",
+			wantErr: "sources[0] (bigtable): unknown source type",
+		},
+		{
+			name:    "duplicate source id",
+			yaml:    "sources:\n  - type: remote\n    id: dc\n  - type: sql\n    id: dc\n",
+			wantErr: "sources[1] (sql): duplicate id dc",
+		},
+		{
+			name:    "missing spanner database",
+			yaml:    "sources:\n  - type: spanner\n    spanner:\n      project: p\n      instance: i\n",
+			wantErr: "sources[0] (spanner): spanner.project, spanner.instance and spanner.database are required",
+		},
+		{
+			name:    "connection of another type",
+			yaml:    "sources:\n  - type: remote\n    sqlite_path: datacommons.db\n",
+			wantErr: "sources[0] (remote): sqlite_path and cloudsql_instance are only supported for sql sources",
+		},
+		{
+			name:    "negative timeout",
+			yaml:    "sources:\n  - type: remote\n    timeout: -1s\n",
+			wantErr: "sources[0] (remote): timeout must not be negative",
+		},
+		{
+			name:    "negative remote attempts",
+			yaml:    "sources:\n  - type: remote\n    remote:\n      max_attempts: -1\n",
+			wantErr: "sources[0] (remote): remote.max_attempts must not be negative",
+		},
+		{
+			name:    "grpc transport without address",
+			yaml:    "sources:\n  - type: remote\n    remote:\n      transport: grpc\n",
+			wantErr: "sources[0] (remote): remote.grpc_address is required for the grpc transport",
+		},
+		{
+			name:    "invalid merge policy",
+			yaml:    "merge_policy: last\nsources:\n  - type: remote\n",
+			wantErr: "merge_policy: ",
+		},
+		{
+			name:    "cache without redis",
+			yaml:    "sources:\n  - type: remote\nprocessors:\n  - type: cache\n",
+			wantErr: "processors[0] (cache): redis.instances are required",
+		},
+		{
+			name:    "lru cache without size",
+			yaml:    "sources:\n  - type: remote\nprocessors:\n  - type: lru_cache\n    lru:\n      ttl: 1h\n",
+			wantErr: "processors[0] (lru_cache): lru.max_bytes must be positive",
+		},
+		{
+			name:    "singleflight settings of another type",
+			yaml:    "sources:\n  - type: remote\nprocessors:\n  - type: calculation\n    singleflight:\n      timeout: 1s\n",
+			wantErr: "processors[0] (calculation): singleflight is only supported for singleflight processors",
+		},
+		{
+			name:    "negative calculation timeout",
+			yaml:    "sources:\n  - type: remote\nprocessors:\n  - type: calculation\n    calculation:\n      timeout: -1s\n",
+			wantErr: "processors[0] (calculation): calculation.timeout and calculation.concurrency must not be negative",
+		},
+		{
+			name:    "calculation settings of another type",
+			yaml:    "sources:\n  - type: remote\nprocessors:\n  - type: aggregation\n    calculation:\n      timeout: 1s\n",
+			wantErr: "processors[0] (aggregation): calculation is only supported for calculation processors",
+		},
+		{
+			name:    "unknown processor type",
+			yaml:    "sources:\n  - type: remote\nprocessors:\n  - type: logging\n",
+			wantErr: "processors[0] (logging): unknown processor type",
+		},
+	} {
+		got, err := Parse([]byte(tc.yaml))
+		if tc.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("Parse (%s) error = %v, want error containing %q", tc.name, err, tc.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Parse error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
+
+func TestNewDispatcher(t *testing.T) {
+	cfg, err := Parse([]byte(`
+sources:
+  - type: mock
+    id: local-files
+    paths: [../../../../test/triples.csv]
+processors:
+  - type: singleflight
+  - type: calculation
+`))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	d, closeAll, err := NewDispatcher(context.Background(), cfg, &Dependencies{})
+	if err != nil {
+		t.Fatalf("NewDispatcher error: %v", err)
+	}
+	defer closeAll()
+
+	got, err := d.Node(context.Background(), &pbv2.NodeRequest{Nodes: []string{"dc/g/SQLite"}, Property: "->"})
+	if err != nil {
+		t.Fatalf("Node error: %v", err)
+	}
+	want := []string{"name", "specializationOf", "typeOf"}
+	if diff := cmp.Diff(got.GetData()["dc/g/SQLite"].GetProperties(), want); diff != "" {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+
+	cfg.Sources = append(cfg.Sources, &SourceConfig{Type: datasource.TypeSQL})
+	if _, _, err := NewDispatcher(context.Background(), cfg, &Dependencies{}); err == nil {
+		t.Errorf("NewDispatcher with an unconnected sql source = nil error, want error")
+	}
+}
+
+func TestNewDispatcherSources(t *testing.T) {
+	cfg, err := Parse([]byte(`
+sources:
+  - type: mock
+    paths: [../../../../test/triples.csv]
+  - type: sql
+    id: custom
+    prefixes: [dc/g/]
+    sqlite_path: ../../../../test/sqlquery/statvar_summary/datacommons.db
+`))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	d, closeAll, err := NewDispatcher(context.Background(), cfg, &Dependencies{})
+	if err != nil {
+		t.Fatalf("NewDispatcher error: %v", err)
+	}
+	defer closeAll()
+
+	// The sql source opens its own database, which is the only one with var1.
+	got, err := d.Node(context.Background(), &pbv2.NodeRequest{Nodes: []string{"var1"}, Property: "->"})
+	if err != nil {
+		t.Fatalf("Node error: %v", err)
+	}
+	if len(got.GetData()["var1"].GetProperties()) == 0 {
+		t.Errorf("Node returned no properties of var1, want the ones of the sql source")
+	}
+	// The node is only routed to the sql source, which doesn't have it.
+	got, err = d.Node(context.Background(), &pbv2.NodeRequest{Nodes: []string{"dc/g/SQLite"}, Property: "->"})
+	if err != nil {
+		t.Fatalf("Node error: %v", err)
+	}
+	if len(got.GetData()["dc/g/SQLite"].GetProperties()) > 0 {
+		t.Errorf("Node returned properties of dc/g/SQLite from the mock source, want it routed to the sql source only")
+	}
+
+	// Sources of the same type need unique ids.
+	cfg.Sources = append(cfg.Sources, &SourceConfig{Type: datasource.TypeMock, Paths: cfg.Sources[0].Paths})
+	if _, _, err := NewDispatcher(context.Background(), cfg, &Dependencies{}); err == nil || !strings.Contains(err.Error(), "duplicate id mock-local") {
+		t.Errorf("NewDispatcher with duplicate source ids error = %v, want duplicate id error", err)
+	}
+	cfg.Sources[2].Id = "other"
+	_, closeOther, err := NewDispatcher(context.Background(), cfg, &Dependencies{})
+	if err != nil {
+		t.Fatalf("NewDispatcher with two mock sources error: %v", err)
+	}
+	closeOther()
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datacommonsorg/mixer/internal/merger"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/datasources"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
+	v2obs "github.com/datacommonsorg/mixer/internal/server/v2/observation"
+)
+
+// AggregationProcessor implements the dispatcher.Processor interface for aggregating
+// the observations of child places into places without observations, for requests that select an aggregation.
+type AggregationProcessor struct {
+	dataSources *datasources.DataSources
+}
+
+func NewAggregationProcessor(dataSources *datasources.DataSources) *AggregationProcessor {
+	return &AggregationProcessor{dataSources: dataSources}
+}
+
+func (processor *AggregationProcessor) PreProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	// Aggregation doesn't require preprocessing.
+	return dispatcher.Continue, nil
+}
+
+func (processor *AggregationProcessor) PostProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	if rc.Type != dispatcher.TypeObservation {
+		return dispatcher.Continue, nil
+	}
+	curResp := rc.CurrentResponse.(*pbv2.ObservationResponse)
+	aggregatedResp, err := v2obs.AggregateHoles(rc.Context, processor, rc.CurrentRequest.(*pbv2.ObservationRequest), curResp)
+	if err != nil || aggregatedResp == nil {
+		return dispatcher.Continue, err
+	}
+	rc.CurrentResponse = merger.MergeMultiObservation([]*pbv2.ObservationResponse{curResp, aggregatedResp})
+	return dispatcher.Continue, nil
+}
+
+// ChildPlaces implements v2obs.AggregationSource with a Node request to the data sources.
+func (processor *AggregationProcessor) ChildPlaces(ctx context.Context, parents []string, childType string) (map[string][]string, error) {
+	req := &pbv2.NodeRequest{
+		Nodes:    parents,
+		Property: fmt.Sprintf("<-containedInPlace+{typeOf:%s}", childType),
+	}
+	result := map[string][]string{}
+	for {
+		resp, err := processor.dataSources.Node(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, parent := range parents {
+			for _, node := range resp.GetData()[parent].GetArcs()["containedInPlace+"].GetNodes() {
+				result[parent] = append(result[parent], node.GetDcid())
+			}
+		}
+		if resp.GetNextToken() == "" {
+			return result, nil
+		}
+		req.NextToken = resp.GetNextToken()
+	}
+}
+
+// Observation implements v2obs.AggregationSource.
+func (processor *AggregationProcessor) Observation(ctx context.Context, req *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
+	return processor.dataSources.Observation(ctx, req)
+}
@@ -15,29 +15,84 @@
 package observation
 
 import (
-	"fmt"
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/datacommonsorg/mixer/internal/merger"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
 	"github.com/datacommonsorg/mixer/internal/server/datasources"
 	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
 	"github.com/datacommonsorg/mixer/internal/server/statvar/formula"
 	v2obs "github.com/datacommonsorg/mixer/internal/server/v2/observation"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"golang.org/x/sync/errgroup"
 )
 
+const (
+	// DefaultCalculationTimeout is the default time limit of calculating the missing observations of a response.
+	DefaultCalculationTimeout = 10 * time.Second
+	// DefaultCalculationConcurrency is the default number of formulas evaluated concurrently.
+	DefaultCalculationConcurrency = 8
+)
+
+// CalculationConfig is the configuration of a CalculationProcessor.
+type CalculationConfig struct {
+	// Time limit of calculating the missing observations of a response, e.g. 5s. Defaults to DefaultCalculationTimeout.
+	// Responses are returned without calculated observations when it runs out.
+	Timeout time.Duration `yaml:"timeout"`
+	// Number of formulas evaluated concurrently. Defaults to DefaultCalculationConcurrency.
+	Concurrency int `yaml:"concurrency"`
+}
+
 // CalculationProcessor implements the dispatcher.Processor interface for performing calculations.
+//
+// Missing observations of variables with formulas are calculated from the inputs of all the formulas,
+// fetched in one request. The formulas are evaluated concurrently, and the first formula of a variable
+// with observations for an entity is preferred. Calculated observations have the facet of their inputs
+// with the formula.
 type CalculationProcessor struct {
 	dataSources *datasources.DataSources
 	svFormulas  map[string][]string
+	timeout     time.Duration
+	concurrency int
 }
 
-func NewCalculationProcessor(dataSources *datasources.DataSources, svFormulas map[string][]string) *CalculationProcessor {
-	return &CalculationProcessor{dataSources: dataSources, svFormulas: svFormulas}
+func NewCalculationProcessor(dataSources *datasources.DataSources, svFormulas map[string][]string, config *CalculationConfig) *CalculationProcessor {
+	processor := &CalculationProcessor{
+		dataSources: dataSources,
+		svFormulas:  svFormulas,
+		timeout:     DefaultCalculationTimeout,
+		concurrency: DefaultCalculationConcurrency,
+	}
+	if config != nil && config.Timeout > 0 {
+		processor.timeout = config.Timeout
+	}
+	if config != nil && config.Concurrency > 0 {
+		processor.concurrency = config.Concurrency
+	}
+	return processor
 }
 
 func (processor *CalculationProcessor) PreProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
-	// Calculation doesn't require preprocessing.
-	return dispatcher.Continue, nil
+	if rc.Type != dispatcher.TypeObservation {
+		return dispatcher.Continue, nil
+	}
+	// Formula variables are evaluated from the observations of their variables, instead of the data sources.
+	curReq := rc.CurrentRequest.(*pbv2.ObservationRequest)
+	if curReq.GetVariable().GetFormula() == "" {
+		return dispatcher.Continue, nil
+	}
+	resp, err := v2obs.FormulaObservation(rc.Context, processor.dataSources, curReq)
+	if err != nil {
+		return dispatcher.Continue, err
+	}
+	rc.CurrentResponse = resp
+	return dispatcher.Done, nil
 }
 
 func (processor *CalculationProcessor) PostProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
@@ -50,7 +105,14 @@ func (processor *CalculationProcessor) PostProcess(rc *dispatcher.RequestContext
 }
 
 func (processor *CalculationProcessor) postProcessObservation(rc *dispatcher.RequestContext) error {
-	calculatedResp, err := processor.calculateHoles(rc)
+	ctx, cancel := context.WithTimeout(rc.Context, processor.timeout)
+	defer cancel()
+	calculatedResp, err := processor.calculateHoles(ctx, rc)
+	// Data sources may wrap or convert context errors, so the timeout is detected from the contexts.
+	if err != nil && ctx.Err() == context.DeadlineExceeded && rc.Context.Err() == nil {
+		log.Printf("Calculating missing observations timed out after %v", processor.timeout)
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -60,78 +122,201 @@ func (processor *CalculationProcessor) postProcessObservation(rc *dispatcher.Req
 	return nil
 }
 
-// calculate computes a calculation for a variable and entity, based on a formula and input data.
-func (processor *CalculationProcessor) calculate(rc *dispatcher.RequestContext, equation *v2obs.Equation, entity *pbv2.DcidOrExpression) (*pbv2.ObservationResponse, error) {
+// A candidate formula for the missing observations of a variable.
+type candidate struct {
+	equation *v2obs.Equation
+	formula  *formula.VariableFormula
+}
+
+// allDates returns whether the inputs of a candidate are fetched for all dates, for time functions
+// that use the observations of other dates than the date of the request.
+func (c *candidate) allDates(req *pbv2.ObservationRequest) bool {
+	return c.formula.HasTimeFunctions && req.GetDate() != ""
+}
 
-	// Parse formula.
-	variableFormula, err := formula.NewVariableFormula(equation.Formula)
-	if err != nil {
-		return nil, err
+// fetchInputs fetches the inputs of all candidates for the entities with missing observations in one request,
+// and another for all dates if some candidates have time functions. The responses are keyed by allDates.
+func (processor *CalculationProcessor) fetchInputs(
+	ctx context.Context,
+	curReq *pbv2.ObservationRequest,
+	entity *pbv2.DcidOrExpression,
+	candidates []*candidate,
+) (map[bool]*pbv2.ObservationResponse, error) {
+	variables := map[bool]map[string]bool{}
+	for _, c := range candidates {
+		allDates := c.allDates(curReq)
+		if variables[allDates] == nil {
+			variables[allDates] = map[string]bool{}
+		}
+		for _, variable := range c.formula.StatVars {
+			variables[allDates][variable] = true
+		}
 	}
-	if len(variableFormula.StatVars) == 0 {
-		return nil, fmt.Errorf("formula missing variables")
+
+	var mu sync.Mutex
+	inputs := map[bool]*pbv2.ObservationResponse{}
+	errGroup, errCtx := errgroup.WithContext(ctx)
+	for allDates, dcids := range variables {
+		allDates, req := allDates, &pbv2.ObservationRequest{
+			Variable: &pbv2.DcidOrExpression{Dcids: util.KeysToSlice(dcids)},
+			Entity:   entity,
+			Date:     curReq.GetDate(),
+			Filter:   curReq.GetFilter(),
+			Select:   curReq.GetSelect(),
+		}
+		if allDates {
+			req.Date = ""
+		}
+		errGroup.Go(func() error {
+			resp, err := processor.dataSources.Observation(errCtx, req)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			inputs[allDates] = resp
+			return nil
+		})
+	}
+	if err := errGroup.Wait(); err != nil {
+		return nil, err
 	}
+	return inputs, nil
+}
 
-	// Retrieve input observations.
-	curReq := rc.CurrentRequest.(*pbv2.ObservationRequest)
-	newReq := &pbv2.ObservationRequest{
-		Variable: &pbv2.DcidOrExpression{Dcids: variableFormula.StatVars},
-		Entity:   entity,
-		Date:     curReq.Date,
-		Value:    curReq.Value,
-		Filter:   curReq.Filter,
-		Select:   curReq.Select,
-	}
-	inputObs, err := processor.dataSources.Observation(rc.Context, newReq)
+// evaluate evaluates a candidate formula with its input observations.
+func evaluate(curReq *pbv2.ObservationRequest, c *candidate, inputObs *pbv2.ObservationResponse) (*pbv2.ObservationResponse, error) {
+	// The value filter applies to the calculated values, not to the inputs.
+	// Time functions use the observations of other dates, so the date applies to the calculated values too.
+	valueFilter, err := util.ParseValueFilter(curReq.GetValue())
 	if err != nil {
 		return nil, err
 	}
+	resp, err := v2obs.EvalExpr(c.formula, inputObs, c.equation)
+	if err != nil {
+		return nil, err
+	}
+	if c.allDates(curReq) {
+		if err := util.FilterObservationsByRequestDate(resp, curReq.GetDate()); err != nil {
+			return nil, err
+		}
+	}
+	util.FilterObservationsByValue(resp, valueFilter)
 
-	// Evaluate formula using input observations.
-	return v2obs.EvalExpr(variableFormula, inputObs, equation)
+	return resp, nil
 }
 
 // calculateHoles detects holes in a ObservationResponse and attempts to fill them using calculations.
-func (processor *CalculationProcessor) calculateHoles(rc *dispatcher.RequestContext) ([]*pbv2.ObservationResponse, error) {
+func (processor *CalculationProcessor) calculateHoles(ctx context.Context, rc *dispatcher.RequestContext) ([]*pbv2.ObservationResponse, error) {
 	curReq, curResp := rc.CurrentRequest.(*pbv2.ObservationRequest), rc.CurrentResponse.(*pbv2.ObservationResponse)
-	result := []*pbv2.ObservationResponse{}
 
+	// Candidate formulas in order of variable and preference.
 	holes := v2obs.FindObservationResponseHoles(curReq, curResp)
-	for variable, entity := range holes {
-		formulas, ok := processor.svFormulas[variable]
-		if !ok {
-			continue
+	variables := []string{}
+	for variable := range holes {
+		if _, ok := processor.svFormulas[variable]; ok {
+			variables = append(variables, variable)
 		}
-		currentEntity := entity
-		for _, formula := range formulas {
-			calculatedResp, err := processor.calculate(rc, &v2obs.Equation{Variable: variable, Formula: formula}, currentEntity)
+	}
+	sort.Strings(variables)
+	candidates := []*candidate{}
+	holeEntities := map[string]bool{}
+	for _, variable := range variables {
+		for _, f := range processor.svFormulas[variable] {
+			variableFormula, err := formula.NewVariableFormula(f)
 			if err != nil {
 				return nil, err
 			}
+			if len(variableFormula.StatVars) == 0 {
+				return nil, errors.New("formula missing variables")
+			}
+			candidates = append(candidates, &candidate{
+				equation: &v2obs.Equation{Variable: variable, Formula: f},
+				formula:  variableFormula,
+			})
+		}
+		for _, dcid := range holes[variable].GetDcids() {
+			holeEntities[dcid] = true
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	// Fetch the inputs of all candidates for the entities with holes.
+	entity := &pbv2.DcidOrExpression{Expression: curReq.GetEntity().GetExpression()}
+	if len(holeEntities) > 0 {
+		entity = &pbv2.DcidOrExpression{Dcids: util.KeysToSlice(holeEntities)}
+	}
+	inputs, err := processor.fetchInputs(ctx, curReq, entity, candidates)
+	if err != nil {
+		return nil, err
+	}
 
-			// Evaluate calculated response to check if there are still holes.
-			variableObs := calculatedResp.ByVariable[variable]
-			if entity.Expression != "" {
-				if len(variableObs.ByEntity) > 0 {
-					result = append(result, calculatedResp)
-					break
-				}
-			} else {
-				newEntityDcids := []string{}
-				for _, dcid := range entity.Dcids {
-					if _, ok := variableObs.ByEntity[dcid]; !ok {
-						newEntityDcids = append(newEntityDcids, dcid)
-					}
-				}
-				if len(newEntityDcids) < len(currentEntity.Dcids) {
-					result = append(result, calculatedResp)
-					if len(newEntityDcids) == 0 {
-						break
-					}
-				}
-				currentEntity = &pbv2.DcidOrExpression{Dcids: newEntityDcids}
+	// Evaluate the candidates concurrently.
+	calculatedResps := make([]*pbv2.ObservationResponse, len(candidates))
+	errGroup, errCtx := errgroup.WithContext(ctx)
+	errGroup.SetLimit(processor.concurrency)
+	for i, c := range candidates {
+		i, c := i, c
+		errGroup.Go(func() error {
+			if err := errCtx.Err(); err != nil {
+				return err
 			}
+			resp, err := evaluate(curReq, c, inputs[c.allDates(curReq)])
+			calculatedResps[i] = resp
+			return err
+		})
+	}
+	if err := errGroup.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Fill the holes of each variable with its preferred formulas.
+	result := []*pbv2.ObservationResponse{}
+	remaining := map[string]map[string]struct{}{}
+	done := map[string]bool{}
+	for i, c := range candidates {
+		variable := c.equation.Variable
+		calculatedResp := calculatedResps[i]
+		variableObs := calculatedResp.GetByVariable()[variable]
+		if done[variable] || variableObs == nil {
+			continue
+		}
+		hole := holes[variable]
+		if hole.GetExpression() != "" {
+			if len(variableObs.GetByEntity()) > 0 {
+				result = append(result, calculatedResp)
+				done[variable] = true
+			}
+			continue
+		}
+		if _, ok := remaining[variable]; !ok {
+			remaining[variable] = map[string]struct{}{}
+			for _, dcid := range hole.GetDcids() {
+				remaining[variable][dcid] = struct{}{}
+			}
+		}
+		// Inputs are fetched for the holes of all variables, so other entities and their facets are dropped.
+		facets := map[string]*pb.Facet{}
+		for entity, entityObs := range variableObs.GetByEntity() {
+			if _, ok := remaining[variable][entity]; !ok {
+				delete(variableObs.ByEntity, entity)
+				continue
+			}
+			for _, facetObs := range entityObs.GetOrderedFacets() {
+				facets[facetObs.GetFacetId()] = calculatedResp.GetFacets()[facetObs.GetFacetId()]
+			}
+		}
+		if len(variableObs.GetByEntity()) == 0 {
+			continue
+		}
+		calculatedResp.Facets = facets
+		result = append(result, calculatedResp)
+		for entity := range variableObs.GetByEntity() {
+			delete(remaining[variable], entity)
 		}
+		done[variable] = len(remaining[variable]) == 0
 	}
 	return result, nil
 }
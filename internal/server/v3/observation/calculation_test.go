@@ -0,0 +1,272 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/datacommonsorg/mixer/internal/merger"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/datasource"
+	"github.com/datacommonsorg/mixer/internal/server/datasources"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// fakeDataSource serves Observation requests from fixed values keyed by variable, entity and date,
+// or blocks until the context is done.
+type fakeDataSource struct {
+	datasource.DataSource
+	values map[string]map[string]map[string]float64
+	block  bool
+	// Converts the context error when blocked, like data sources that return gRPC status errors.
+	blockErr func(error) error
+
+	mu      sync.Mutex
+	gotReqs []*pbv2.ObservationRequest
+}
+
+func (ds *fakeDataSource) Type() datasource.DataSourceType {
+	return datasource.TypeMock
+}
+
+func (ds *fakeDataSource) Id() string {
+	return "fake"
+}
+
+func (ds *fakeDataSource) Observation(ctx context.Context, req *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
+	ds.mu.Lock()
+	ds.gotReqs = append(ds.gotReqs, req)
+	ds.mu.Unlock()
+	if ds.block {
+		<-ctx.Done()
+		if ds.blockErr != nil {
+			return nil, ds.blockErr(ctx.Err())
+		}
+		return nil, ctx.Err()
+	}
+	resp := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{},
+		Facets:     map[string]*pb.Facet{"census": {ImportName: "CensusPEP"}},
+	}
+	for _, variable := range req.GetVariable().GetDcids() {
+		resp.ByVariable[variable] = &pbv2.VariableObservation{ByEntity: map[string]*pbv2.EntityObservation{}}
+		for _, entity := range req.GetEntity().GetDcids() {
+			entityObs := &pbv2.EntityObservation{}
+			if values, ok := ds.values[variable][entity]; ok {
+				entityObs.OrderedFacets = []*pbv2.FacetObservation{facetObservations("census", values)}
+			}
+			resp.ByVariable[variable].ByEntity[entity] = entityObs
+		}
+	}
+	return resp, nil
+}
+
+// facetObservations returns the observations of a facet with a value for each date.
+func facetObservations(facetID string, values map[string]float64) *pbv2.FacetObservation {
+	facetObs := &pbv2.FacetObservation{FacetId: facetID}
+	dates := []string{}
+	for date := range values {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+	for _, date := range dates {
+		facetObs.Observations = append(facetObs.Observations, &pb.PointStat{Date: date, Value: proto.Float64(values[date])})
+	}
+	facetObs.ObsCount = int32(len(facetObs.Observations))
+	facetObs.EarliestDate = facetObs.Observations[0].GetDate()
+	facetObs.LatestDate = facetObs.Observations[len(facetObs.Observations)-1].GetDate()
+	return facetObs
+}
+
+func TestCalculateHoles(t *testing.T) {
+	const (
+		byMale     = "Count_Person - Count_Person_Male"
+		byAge      = "Count_Person_Female_Adult + Count_Person_Female_Child"
+		byCropFarm = "Count_Farm_Crop + Count_Farm_Livestock"
+	)
+	svFormulas := map[string][]string{
+		"Count_Person_Female": {byMale, byAge},
+		"Count_Farm":          {byCropFarm},
+	}
+	source := &fakeDataSource{values: map[string]map[string]map[string]float64{
+		"Count_Person":              {"geoId/01": {"2020": 100}},
+		"Count_Person_Male":         {"geoId/01": {"2020": 40}},
+		"Count_Person_Female_Adult": {"geoId/01": {"2020": 1}, "geoId/02": {"2020": 20}},
+		"Count_Person_Female_Child": {"geoId/01": {"2020": 1}, "geoId/02": {"2020": 5}},
+		"Count_Farm_Crop":           {"geoId/02": {"2020": 3}, "geoId/03": {"2020": 7}},
+		"Count_Farm_Livestock":      {"geoId/02": {"2020": 4}, "geoId/03": {"2020": 7}},
+	}}
+	dataSources := datasources.NewDataSources([]*datasource.DataSource{toSource(source)}, nil, merger.MergePolicyUnion, nil)
+
+	req := &pbv2.ObservationRequest{
+		Variable: &pbv2.DcidOrExpression{Dcids: []string{"Count_Person_Female", "Count_Farm"}},
+		Entity:   &pbv2.DcidOrExpression{Dcids: []string{"geoId/01", "geoId/02", "geoId/03"}},
+		Select:   []string{"variable", "entity", "date", "value"},
+	}
+	resp := func() *pbv2.ObservationResponse {
+		return &pbv2.ObservationResponse{
+			ByVariable: map[string]*pbv2.VariableObservation{
+				"Count_Person_Female": {ByEntity: map[string]*pbv2.EntityObservation{
+					"geoId/01": {},
+					"geoId/02": {},
+					"geoId/03": {OrderedFacets: []*pbv2.FacetObservation{facetObservations("census", map[string]float64{"2020": 9})}},
+				}},
+				"Count_Farm": {ByEntity: map[string]*pbv2.EntityObservation{
+					"geoId/01": {},
+					"geoId/02": {},
+					"geoId/03": {OrderedFacets: []*pbv2.FacetObservation{facetObservations("census", map[string]float64{"2020": 2})}},
+				}},
+			},
+			Facets: map[string]*pb.Facet{"census": {ImportName: "CensusPEP"}},
+		}
+	}
+
+	// Calculated facets record their formula.
+	formulaFacet := func(f string) (string, *pb.Facet) {
+		facet := &pb.Facet{ImportName: "CensusPEP", IsDcAggregate: true, Formula: f}
+		return util.GetFacetID(facet), facet
+	}
+	byMaleID, byMaleFacet := formulaFacet(byMale)
+	byAgeID, byAgeFacet := formulaFacet(byAge)
+	byCropFarmID, byCropFarmFacet := formulaFacet(byCropFarm)
+	want := resp()
+	// geoId/01 has inputs of both formulas, and the first is preferred.
+	want.ByVariable["Count_Person_Female"].ByEntity["geoId/01"].OrderedFacets = []*pbv2.FacetObservation{
+		facetObservations(byMaleID, map[string]float64{"2020": 60}),
+	}
+	want.ByVariable["Count_Person_Female"].ByEntity["geoId/02"].OrderedFacets = []*pbv2.FacetObservation{
+		facetObservations(byAgeID, map[string]float64{"2020": 25}),
+	}
+	want.ByVariable["Count_Farm"].ByEntity["geoId/02"].OrderedFacets = []*pbv2.FacetObservation{
+		facetObservations(byCropFarmID, map[string]float64{"2020": 7}),
+	}
+	want.Facets[byMaleID] = byMaleFacet
+	want.Facets[byAgeID] = byAgeFacet
+	want.Facets[byCropFarmID] = byCropFarmFacet
+
+	processor := NewCalculationProcessor(dataSources, svFormulas, &CalculationConfig{Concurrency: 2})
+	rc := &dispatcher.RequestContext{
+		Context:         context.Background(),
+		Type:            dispatcher.TypeObservation,
+		CurrentRequest:  req,
+		CurrentResponse: resp(),
+	}
+	if _, err := processor.PostProcess(rc); err != nil {
+		t.Fatalf("PostProcess error: %v", err)
+	}
+	if diff := cmp.Diff(rc.CurrentResponse, want, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+
+	// The inputs of all formulas are fetched in one request for the entities with holes.
+	wantReqs := []*pbv2.ObservationRequest{{
+		Variable: &pbv2.DcidOrExpression{Dcids: []string{
+			"Count_Farm_Crop", "Count_Farm_Livestock", "Count_Person", "Count_Person_Female_Adult",
+			"Count_Person_Female_Child", "Count_Person_Male",
+		}},
+		Entity: &pbv2.DcidOrExpression{Dcids: []string{"geoId/01", "geoId/02"}},
+		Select: []string{"variable", "entity", "date", "value"},
+	}}
+	if diff := cmp.Diff(source.gotReqs, wantReqs, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected requests diff %v", diff)
+	}
+}
+
+func TestCalculateHolesTimeout(t *testing.T) {
+	resp := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{"Count_Person_Female": {ByEntity: map[string]*pbv2.EntityObservation{
+			"geoId/01": {},
+		}}},
+	}
+	for _, tc := range []struct {
+		name     string
+		blockErr func(error) error
+	}{
+		{"context error", nil},
+		{"wrapped error", func(err error) error { return fmt.Errorf("fetching observations: %w", err) }},
+		{"status error", func(err error) error { return status.FromContextError(err).Err() }},
+		{"unrelated error", func(error) error { return errors.New("connection reset") }},
+	} {
+		source := &fakeDataSource{block: true, blockErr: tc.blockErr}
+		dataSources := datasources.NewDataSources([]*datasource.DataSource{toSource(source)}, nil, merger.MergePolicyUnion, nil)
+		processor := NewCalculationProcessor(dataSources, map[string][]string{
+			"Count_Person_Female": {"Count_Person - Count_Person_Male"},
+		}, &CalculationConfig{Timeout: 10 * time.Millisecond})
+
+		rc := &dispatcher.RequestContext{
+			Context: context.Background(),
+			Type:    dispatcher.TypeObservation,
+			CurrentRequest: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"Count_Person_Female"}},
+				Entity:   &pbv2.DcidOrExpression{Dcids: []string{"geoId/01"}},
+				Select:   []string{"variable", "entity", "date", "value"},
+			},
+			CurrentResponse: proto.Clone(resp),
+		}
+		// The response is returned without calculated observations, whatever the error of the data source.
+		if _, err := processor.PostProcess(rc); err != nil {
+			t.Fatalf("PostProcess error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(rc.CurrentResponse, resp, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
+
+func TestCalculateHolesCancelled(t *testing.T) {
+	source := &fakeDataSource{block: true, blockErr: func(err error) error { return status.FromContextError(err).Err() }}
+	dataSources := datasources.NewDataSources([]*datasource.DataSource{toSource(source)}, nil, merger.MergePolicyUnion, nil)
+	processor := NewCalculationProcessor(dataSources, map[string][]string{
+		"Count_Person_Female": {"Count_Person - Count_Person_Male"},
+	}, &CalculationConfig{Timeout: time.Minute})
+
+	// The deadline of the request itself is an error, not a calculation timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	rc := &dispatcher.RequestContext{
+		Context: ctx,
+		Type:    dispatcher.TypeObservation,
+		CurrentRequest: &pbv2.ObservationRequest{
+			Variable: &pbv2.DcidOrExpression{Dcids: []string{"Count_Person_Female"}},
+			Entity:   &pbv2.DcidOrExpression{Dcids: []string{"geoId/01"}},
+			Select:   []string{"variable", "entity", "date", "value"},
+		},
+		CurrentResponse: &pbv2.ObservationResponse{
+			ByVariable: map[string]*pbv2.VariableObservation{"Count_Person_Female": {ByEntity: map[string]*pbv2.EntityObservation{
+				"geoId/01": {},
+			}}},
+		},
+	}
+	if _, err := processor.PostProcess(rc); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("PostProcess error = %v, want DeadlineExceeded", err)
+	}
+}
+
+func toSource(ds datasource.DataSource) *datasource.DataSource {
+	return &ds
+}
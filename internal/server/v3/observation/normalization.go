@@ -0,0 +1,68 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observation
+
+import (
+	"context"
+
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/datasources"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
+	v2obs "github.com/datacommonsorg/mixer/internal/server/v2/observation"
+)
+
+// denominatorRequestKey is the context key of the observation request that has a denominator,
+// while the request is replaced with its numerator request.
+type denominatorRequestKey struct{}
+
+// NormalizationProcessor implements the dispatcher.Processor interface for dividing observations
+// by a denominator variable, for requests that have one.
+//
+// It replaces the request with the request for numerators, so that the processors after it
+// and the data sources handle numerators, and restores the request before the processors
+// before it, e.g. caches, see the response.
+type NormalizationProcessor struct {
+	dataSources *datasources.DataSources
+}
+
+func NewNormalizationProcessor(dataSources *datasources.DataSources) *NormalizationProcessor {
+	return &NormalizationProcessor{dataSources: dataSources}
+}
+
+func (processor *NormalizationProcessor) PreProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	if rc.Type != dispatcher.TypeObservation {
+		return dispatcher.Continue, nil
+	}
+	curReq := rc.CurrentRequest.(*pbv2.ObservationRequest)
+	numeratorReq, err := v2obs.NumeratorRequest(curReq)
+	if err != nil || numeratorReq == curReq {
+		return dispatcher.Continue, err
+	}
+	rc.Context = context.WithValue(rc.Context, denominatorRequestKey{}, curReq)
+	rc.CurrentRequest = numeratorReq
+	return dispatcher.Continue, nil
+}
+
+func (processor *NormalizationProcessor) PostProcess(rc *dispatcher.RequestContext) (dispatcher.Outcome, error) {
+	if rc.Type != dispatcher.TypeObservation {
+		return dispatcher.Continue, nil
+	}
+	in, ok := rc.Value(denominatorRequestKey{}).(*pbv2.ObservationRequest)
+	if !ok {
+		return dispatcher.Continue, nil
+	}
+	rc.CurrentRequest = in
+	return dispatcher.Continue, v2obs.Normalize(rc.Context, processor.dataSources, in, rc.CurrentResponse.(*pbv2.ObservationResponse))
+}
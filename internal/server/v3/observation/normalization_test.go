@@ -0,0 +1,84 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/merger"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/datasource"
+	"github.com/datacommonsorg/mixer/internal/server/datasources"
+	"github.com/datacommonsorg/mixer/internal/server/dispatcher"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestNormalizationProcessor(t *testing.T) {
+	source := &fakeDataSource{values: map[string]map[string]map[string]float64{
+		"Count_Person": {"geoId/01": {"2020": 200}},
+	}}
+	dataSources := datasources.NewDataSources([]*datasource.DataSource{toSource(source)}, nil, merger.MergePolicyUnion, nil)
+	processor := NewNormalizationProcessor(dataSources)
+
+	req := &pbv2.ObservationRequest{
+		Variable:    &pbv2.DcidOrExpression{Dcids: []string{"Count_Farm"}},
+		Entity:      &pbv2.DcidOrExpression{Dcids: []string{"geoId/01"}},
+		Value:       ">0.1",
+		Select:      []string{"variable", "entity", "date", "value"},
+		Denominator: "Count_Person",
+	}
+	rc := &dispatcher.RequestContext{
+		Context:        context.Background(),
+		Type:           dispatcher.TypeObservation,
+		CurrentRequest: req,
+	}
+	if _, err := processor.PreProcess(rc); err != nil {
+		t.Fatalf("PreProcess error: %v", err)
+	}
+	// The processors after it and the data sources see the numerator request.
+	wantNumeratorReq := &pbv2.ObservationRequest{
+		Variable: &pbv2.DcidOrExpression{Dcids: []string{"Count_Farm"}},
+		Entity:   &pbv2.DcidOrExpression{Dcids: []string{"geoId/01"}},
+		Select:   []string{"variable", "entity", "date", "value"},
+	}
+	if diff := cmp.Diff(rc.CurrentRequest, wantNumeratorReq, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected numerator request diff %v", diff)
+	}
+
+	rc.CurrentResponse = &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{"Count_Farm": {ByEntity: map[string]*pbv2.EntityObservation{
+			"geoId/01": {OrderedFacets: []*pbv2.FacetObservation{facetObservations("census", map[string]float64{"2020": 50})}},
+		}}},
+		Facets: map[string]*pb.Facet{"census": {ImportName: "CensusPEP"}},
+	}
+	if _, err := processor.PostProcess(rc); err != nil {
+		t.Fatalf("PostProcess error: %v", err)
+	}
+	if diff := cmp.Diff(rc.CurrentRequest, req, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected restored request diff %v", diff)
+	}
+	want := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{"Count_Farm": {ByEntity: map[string]*pbv2.EntityObservation{
+			"geoId/01": {OrderedFacets: []*pbv2.FacetObservation{facetObservations("census", map[string]float64{"2020": 0.25})}},
+		}}},
+		Facets: map[string]*pb.Facet{"census": {ImportName: "CensusPEP"}},
+	}
+	if diff := cmp.Diff(rc.CurrentResponse, want, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+}
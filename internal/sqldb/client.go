@@ -50,6 +50,12 @@ const (
 type SQLClient struct {
 	dbx *sqlx.DB
 	id  string
+	// Path to the SQLite database file. Empty for other drivers.
+	sqlitePath string
+	// In-memory SQLite database holding a full-text (FTS5) index of triple object values.
+	// It is built by ValidateDatabase for SQLite databases.
+	// If nil, node searches fall back to LIKE queries against the triples table.
+	searchIndex *sqlx.DB
 }
 
 // UseConnections uses connections from the src client to this client.
@@ -58,10 +64,17 @@ type SQLClient struct {
 func (sc *SQLClient) UseConnections(src *SQLClient) {
 	sc.dbx = src.dbx
 	sc.id = src.id
+	sc.sqlitePath = src.sqlitePath
+	sc.searchIndex = src.searchIndex
 }
 
 // Close closes the underlying database connection
 func (sc *SQLClient) Close() error {
+	if sc.searchIndex != nil {
+		if err := sc.searchIndex.Close(); err != nil {
+			return err
+		}
+	}
 	if sc.dbx != nil {
 		return sc.dbx.Close()
 	}
@@ -77,7 +90,9 @@ func NewSQLiteClient(sqlitePath string) (*SQLClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newSQLClient(db, sqliteDriver, fmt.Sprintf("%s-%s", sqliteDriver, sqlitePath)), nil
+	client := newSQLClient(db, sqliteDriver, fmt.Sprintf("%s-%s", sqliteDriver, sqlitePath))
+	client.sqlitePath = sqlitePath
+	return client, nil
 }
 
 func NewCloudSQLClient(instanceName string) (*SQLClient, error) {
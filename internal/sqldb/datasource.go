@@ -17,10 +17,18 @@ package sqldb
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 
+	"github.com/datacommonsorg/mixer/internal/merger"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv1 "github.com/datacommonsorg/mixer/internal/proto/v1"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
 	"github.com/datacommonsorg/mixer/internal/server/datasource"
+	"github.com/datacommonsorg/mixer/internal/server/datasource/dsutil"
 	v2 "github.com/datacommonsorg/mixer/internal/server/v2"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // SQLDataSource represents a data source that interacts with SQL.
@@ -30,10 +38,48 @@ type SQLDataSource struct {
 	// If one is not configured, those calls will be skipped.
 	// The secondary data source is typically a remote data source but it could be a different data source (like spanner) in tests.
 	secondaryDataSource datasource.DataSource
+	// Provenances in the SQL database keyed by provenance id, used to populate observation facets.
+	// They are loaded when the data source is created and reloaded when the database changes, see Reload.
+	provenances atomic.Pointer[map[string]*pb.Facet]
+	// Entity and variable pairs with observations in the SQL database, used to route observation requests.
+	// They are loaded and reloaded with the provenances.
+	existence atomic.Pointer[map[util.EntityVariable]struct{}]
 }
 
-func NewSQLDataSource(client *SQLClient, secondaryDataSource datasource.DataSource) *SQLDataSource {
-	return &SQLDataSource{client: client, secondaryDataSource: secondaryDataSource}
+func NewSQLDataSource(client *SQLClient, secondaryDataSource datasource.DataSource) (*SQLDataSource, error) {
+	sds := &SQLDataSource{
+		client:              client,
+		secondaryDataSource: secondaryDataSource,
+	}
+	if err := sds.Reload(context.Background()); err != nil {
+		return nil, err
+	}
+	return sds, nil
+}
+
+// Reload reloads the provenances and observation existence of the SQL database, e.g. after the database is updated.
+func (sds *SQLDataSource) Reload(ctx context.Context) error {
+	provenances, err := sds.client.GetProvenanceFacets(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting provenances: %w", err)
+	}
+	rows, err := sds.client.GetAllEntitiesAndVariables(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting entities and variables: %w", err)
+	}
+	existence := map[util.EntityVariable]struct{}{}
+	for _, row := range rows {
+		existence[util.EntityVariable{E: row.Entity, V: row.Variable}] = struct{}{}
+		existence[util.EntityVariable{V: row.Variable}] = struct{}{}
+	}
+	sds.provenances.Store(&provenances)
+	sds.existence.Store(&existence)
+	return nil
+}
+
+// Existence returns the entity and variable pairs with observations in the SQL database.
+func (sds *SQLDataSource) Existence() map[util.EntityVariable]struct{} {
+	return *sds.existence.Load()
 }
 
 // Type returns the type of the data source.
@@ -55,10 +101,10 @@ func (sds *SQLDataSource) Node(ctx context.Context, req *pbv2.NodeRequest) (*pbv
 	if len(arcs) == 0 {
 		return &pbv2.NodeResponse{}, nil
 	}
-	if len(arcs) > 1 {
-		return nil, fmt.Errorf("multiple arcs in node request")
-	}
 	arc := arcs[0]
+	if len(arcs) > 1 || arc.Decorator != "" || (len(arc.Filter) > 0 && !arc.IsNodePropertiesArc()) {
+		return sds.getLinkedNodes(ctx, req.Nodes, arcs)
+	}
 
 	if arc.IsNodePropertiesArc() {
 		nodePredicates, err := sds.client.GetNodePredicates(ctx, req.Nodes, arc.Direction())
@@ -87,24 +133,255 @@ func (sds *SQLDataSource) Node(ctx context.Context, req *pbv2.NodeRequest) (*pbv
 		return triplesToNodeResponse(nodeTriples, entityInfoTriples, arc.Direction()), nil
 	}
 
-	// TODO: Add support for other types of node requests.
-
 	return &pbv2.NodeResponse{}, nil
 }
 
+// getLinkedNodes follows a chain of arcs, possibly chained (+) or filtered, from the nodes.
+// Each arc is evaluated by one query, and the response holds the results of the last arc for each node.
+func (sds *SQLDataSource) getLinkedNodes(ctx context.Context, nodes []string, arcs []*v2.Arc) (*pbv2.NodeResponse, error) {
+	reached, err := v2.FollowArcs(nodes, arcs, func(nodes []string, arc *v2.Arc) (map[string][]string, error) {
+		arcNodes, err := sds.client.GetArcNodes(ctx, nodes, arc)
+		if err != nil {
+			return nil, fmt.Errorf("error getting arc nodes: %v", err)
+		}
+		return arcNodesToReachedNodes(arcNodes), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	arcNodes, err := sds.client.GetArcNodes(ctx, v2.ReachedNodes(reached), arcs[len(arcs)-1])
+	if err != nil {
+		return nil, fmt.Errorf("error getting arc nodes: %v", err)
+	}
+	entityInfoTriples, err := sds.client.GetEntityInfoTriples(ctx, v2.ReachedNodes(arcNodesToReachedNodes(arcNodes)))
+	if err != nil {
+		return nil, fmt.Errorf("error getting entity info: %v", err)
+	}
+	return arcNodesToNodeResponse(reached, arcNodes, entityInfoTriples), nil
+}
+
 // Observation retrieves observation data from SQL.
 func (sds *SQLDataSource) Observation(ctx context.Context, req *pbv2.ObservationRequest) (*pbv2.ObservationResponse, error) {
-	return &pbv2.ObservationResponse{}, nil
+	qo := dsutil.SelectFieldsToQueryOptions(req.GetSelect())
+	if !qo.Variable || !qo.Entity {
+		return nil, status.Error(codes.InvalidArgument, "Must select 'variable' and 'entity'")
+	}
+
+	valueFilter, err := util.ParseValueFilter(req.GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	variables := req.GetVariable().GetDcids()
+	entities, entityExpr := req.GetEntity().GetDcids(), req.GetEntity().GetExpression()
+
+	if qo.IsExistence(variables) {
+		if entityExpr != "" && len(variables) > 0 {
+			childPlaces, err := sds.getChildPlaces(ctx, entityExpr)
+			if err != nil {
+				return nil, err
+			}
+			entities = childPlaces
+		}
+		entityVariables, err := sds.client.GetEntityVariables(ctx, entities)
+		if err != nil {
+			return nil, fmt.Errorf("error getting entity variables: %v", err)
+		}
+		return entityVariablesToObservationResponse(variables, entityVariables), nil
+	}
+
+	if qo.IsEmpty(variables, entities, entityExpr) {
+		return &pbv2.ObservationResponse{}, nil
+	}
+
+	date := qo.ObservationDate(entities, req.GetDate())
+	// Only observations with values are filtered, facet summaries count all of them.
+	queryValueFilter := valueFilter
+	if !qo.Date || !qo.Value {
+		queryValueFilter = nil
+	}
+	var observations []*Observation
+	if entityExpr != "" {
+		observations, err = sds.getObservationsContainedInPlace(ctx, variables, entityExpr, date, queryValueFilter)
+	} else {
+		observations, err = sds.client.GetObservations(ctx, variables, entities, date, queryValueFilter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting observations: %v", err)
+	}
+
+	series := observationsToFacetSeries(observations, *sds.provenances.Load(), date, req.GetFilter())
+	// Latest observations aren't filtered in the query, so values are filtered again.
+	return dsutil.FacetSeriesToObservationResponse(qo, variables, entities, entityExpr, series, valueFilter), nil
+}
+
+func (sds *SQLDataSource) getObservationsContainedInPlace(ctx context.Context, variables []string, entityExpr string, date string, valueFilter *util.ValueFilter) ([]*Observation, error) {
+	containedInPlace, err := v2.ParseContainedInPlace(entityExpr)
+	if err != nil {
+		return nil, err
+	}
+	if containedInPlace.Ancestor == containedInPlace.ChildPlaceType {
+		return sds.client.GetObservationsByEntityType(ctx, variables, containedInPlace.ChildPlaceType, date, valueFilter)
+	}
+	childPlaces, err := sds.getChildPlaces(ctx, entityExpr)
+	if err != nil {
+		return nil, err
+	}
+	return sds.client.GetObservations(ctx, variables, childPlaces, date, valueFilter)
+}
+
+// getChildPlaces returns the child places specified by a contained-in entity expression.
+// Child places are fetched from SQL first.
+// If SQL does not have any, they are fetched from the secondary data source, if one is configured.
+func (sds *SQLDataSource) getChildPlaces(ctx context.Context, entityExpr string) ([]string, error) {
+	containedInPlace, err := v2.ParseContainedInPlace(entityExpr)
+	if err != nil {
+		return nil, err
+	}
+	ancestor, childPlaceType := containedInPlace.Ancestor, containedInPlace.ChildPlaceType
+
+	if ancestor == childPlaceType {
+		rows, err := sds.client.GetAllEntitiesOfType(ctx, childPlaceType)
+		if err != nil {
+			return nil, fmt.Errorf("error getting entities of type %s: %v", childPlaceType, err)
+		}
+		return subjectObjectsToSubjects(rows), nil
+	}
+
+	rows, err := sds.client.GetContainedInPlace(ctx, childPlaceType, []string{ancestor})
+	if err != nil {
+		return nil, fmt.Errorf("error getting child places: %v", err)
+	}
+	childPlaces := subjectObjectsToSubjects(rows)
+	if len(childPlaces) > 0 || sds.secondaryDataSource == nil {
+		return childPlaces, nil
+	}
+
+	req := &pbv2.NodeRequest{
+		Nodes:    []string{ancestor},
+		Property: fmt.Sprintf("<-%s+{%s:%s}", containedInPlacePredicate, typeOfPredicate, childPlaceType),
+	}
+	for {
+		resp, err := sds.secondaryDataSource.Node(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("error getting child places from secondary data source: %v", err)
+		}
+		for _, node := range resp.GetData()[ancestor].GetArcs()[containedInPlacePredicate+"+"].GetNodes() {
+			childPlaces = append(childPlaces, node.GetDcid())
+		}
+		if resp.GetNextToken() == "" {
+			return childPlaces, nil
+		}
+		req.NextToken = resp.GetNextToken()
+	}
 }
 
 // NodeSearch searches nodes in the SQL database.
-// However, node search is not currently supported for SQL data sources so it returns an empty response.
 func (sds *SQLDataSource) NodeSearch(ctx context.Context, req *pbv2.NodeSearchRequest) (*pbv2.NodeSearchResponse, error) {
-	return &pbv2.NodeSearchResponse{}, nil
+	nodes, err := sds.client.SearchObjectValues(ctx, req.Query, req.Predicates, req.Types)
+	if err != nil {
+		return nil, fmt.Errorf("error searching nodes: %v", err)
+	}
+	entityInfoTriples, err := sds.client.GetEntityInfoTriples(ctx, searchNodesToDcids(nodes))
+	if err != nil {
+		return nil, fmt.Errorf("error getting entity info: %v", err)
+	}
+	return searchNodesToNodeSearchResponse(nodes, entityInfoTriples), nil
 }
 
-// Resolve searches for nodes in the graph.
-// However, resolve is not currently supported for SQL data sources so it returns an empty response.
+// Resolve resolves nodes using the triples in the SQL database.
+// Nodes that cannot be resolved locally are resolved using the secondary data source, if one is configured.
 func (sds *SQLDataSource) Resolve(ctx context.Context, req *pbv2.ResolveRequest) (*pbv2.ResolveResponse, error) {
-	return &pbv2.ResolveResponse{}, nil
+	inArc, outArc, err := v2.ParseResolveProperty(req.GetProperty())
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*ResolutionCandidate
+	switch {
+	case inArc.SingleProp == geoCoordinateProperty:
+		// Coordinates cannot be resolved using triples.
+	case inArc.SingleProp == descriptionProperty && outArc.SingleProp == dcidProperty:
+		candidates, err = sds.client.ResolveByName(ctx, req.GetNodes(), inArc.Filter[typeOfPredicate])
+		if err != nil {
+			return nil, fmt.Errorf("error resolving descriptions: %v", err)
+		}
+	default:
+		candidates, err = sds.client.ResolveByID(ctx, req.GetNodes(), inArc.SingleProp, outArc.SingleProp)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving ids: %v", err)
+		}
+	}
+
+	var entityInfoTriples []*Triple
+	if outArc.SingleProp == dcidProperty {
+		entityInfoTriples, err = sds.client.GetEntityInfoTriples(ctx, candidatesToDcids(candidates))
+		if err != nil {
+			return nil, fmt.Errorf("error getting entity info: %v", err)
+		}
+	}
+
+	response := candidatesToResolveResponse(req.GetNodes(), candidates, entityInfoTriples)
+	return sds.resolveFromSecondary(ctx, req.GetProperty(), response)
+}
+
+// Event retrieves events from the triples in SQL.
+func (sds *SQLDataSource) Event(ctx context.Context, req *pbv2.EventRequest) (*pbv2.EventResponse, error) {
+	eventProperty, err := v2.ParseEventProperty(req.GetProperty())
+	if err != nil {
+		return nil, err
+	}
+
+	if eventProperty.Date == "" {
+		dates, err := sds.client.GetEventDates(ctx, eventProperty.EventType, req.GetNode())
+		if err != nil {
+			return nil, fmt.Errorf("error getting event dates: %v", err)
+		}
+		return &pbv2.EventResponse{
+			EventCollectionDate: &pbv1.EventCollectionDate{Dates: dates},
+		}, nil
+	}
+
+	eventTriples, err := sds.client.GetEventTriples(ctx, eventProperty.EventType, req.GetNode(), eventProperty.Date)
+	if err != nil {
+		return nil, fmt.Errorf("error getting events: %v", err)
+	}
+	return eventTriplesToEventResponse(eventTriples, eventProperty.Filter), nil
+}
+
+// Sparql runs a Sparql query against the triples in SQL.
+func (sds *SQLDataSource) Sparql(ctx context.Context, req *pb.SparqlRequest) (*pb.QueryResponse, error) {
+	header, rows, err := sds.client.QueryTriples(ctx, req.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+	return rowsToQueryResponse(header, rows), nil
+}
+
+// resolveFromSecondary resolves nodes without any candidates in the response using the secondary data source.
+// The secondary results are merged into the response.
+func (sds *SQLDataSource) resolveFromSecondary(ctx context.Context, property string, response *pbv2.ResolveResponse) (*pbv2.ResolveResponse, error) {
+	if sds.secondaryDataSource == nil {
+		return response, nil
+	}
+	unresolved := []string{}
+	for _, entity := range response.GetEntities() {
+		if len(entity.GetCandidates()) == 0 {
+			unresolved = append(unresolved, entity.GetNode())
+		}
+	}
+	if len(unresolved) == 0 {
+		return response, nil
+	}
+
+	req := &pbv2.ResolveRequest{
+		Nodes:    unresolved,
+		Property: property,
+	}
+	secondaryResponse, err := sds.secondaryDataSource.Resolve(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving nodes from secondary data source: %v", err)
+	}
+	return merger.MergeResolve(response, secondaryResponse), nil
 }
@@ -15,9 +15,19 @@
 package sqldb
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/datasource"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
 )
 
 func TestId(t *testing.T) {
@@ -28,6 +38,824 @@ func TestId(t *testing.T) {
 
 	assert.Equal(t, "sqlite-../../test/sqlquery/key_value/datacommons.db", sqlClient.id)
 
-	sqlDataSource := NewSQLDataSource(sqlClient, nil)
+	sqlDataSource, err := NewSQLDataSource(sqlClient, nil)
+	if err != nil {
+		t.Fatalf("Could not create SQL data source: %v", err)
+	}
 	assert.Equal(t, "sql-sqlite-../../test/sqlquery/key_value/datacommons.db", sqlDataSource.Id())
 }
+
+func TestReload(t *testing.T) {
+	data, err := os.ReadFile("../../test/datacommons.db")
+	if err != nil {
+		t.Fatalf("Could not read test database: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "datacommons.db")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatalf("Could not copy test database: %v", err)
+	}
+	sqlClient, err := NewSQLiteClient(path)
+	if err != nil {
+		t.Fatalf("Could not open test database: %v", err)
+	}
+	ds, err := NewSQLDataSource(sqlClient, nil)
+	if err != nil {
+		t.Fatalf("Could not create SQL data source: %v", err)
+	}
+
+	// The database is updated after the data source is created.
+	if _, err := sqlClient.dbx.Exec(`UPDATE triples SET object_value = 'Renamed Prov' WHERE subject_id = 'custom' AND predicate = 'name'`); err != nil {
+		t.Fatalf("Could not update test database: %v", err)
+	}
+	if _, err := sqlClient.dbx.Exec(`INSERT INTO observations (entity, variable, date, value, provenance) VALUES ('geoId/99', 'new_var', '2024', '1', 'custom')`); err != nil {
+		t.Fatalf("Could not update test database: %v", err)
+	}
+	if _, ok := ds.Existence()[util.EntityVariable{E: "geoId/99", V: "new_var"}]; ok {
+		t.Errorf("Existence has new_var before Reload")
+	}
+	if err := ds.Reload(context.Background()); err != nil {
+		t.Fatalf("Reload error: %v", err)
+	}
+	want := map[string]*pb.Facet{"custom": {ImportName: "Renamed Prov", ProvenanceUrl: "custom.datacommons.org"}}
+	if diff := cmp.Diff(*ds.provenances.Load(), want, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected provenances diff %v", diff)
+	}
+	for _, pair := range []util.EntityVariable{{E: "geoId/99", V: "new_var"}, {V: "new_var"}} {
+		if _, ok := ds.Existence()[pair]; !ok {
+			t.Errorf("Existence after Reload is missing %v", pair)
+		}
+	}
+}
+
+func TestObservation(t *testing.T) {
+	sqlClient, err := NewSQLiteClient("../../test/datacommons.db")
+	if err != nil {
+		t.Fatalf("Could not open test database: %v", err)
+	}
+	ds, err := NewSQLDataSource(sqlClient, nil)
+	if err != nil {
+		t.Fatalf("Could not create SQL data source: %v", err)
+	}
+
+	customFacet := &pb.Facet{ImportName: "Custom Prov", ProvenanceUrl: "custom.datacommons.org"}
+	customFacetId := util.GetFacetID(customFacet)
+	usdFacet := &pb.Facet{ImportName: "Custom Prov", ProvenanceUrl: "custom.datacommons.org", Unit: "USD"}
+	usdFacetId := util.GetFacetID(usdFacet)
+
+	for _, tc := range []struct {
+		name string
+		req  *pbv2.ObservationRequest
+		want *pbv2.ObservationResponse
+	}{
+		{
+			name: "latest date",
+			req: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"test_var_2"}},
+				Entity:   &pbv2.DcidOrExpression{Dcids: []string{"geoId/05", "fake_entity"}},
+				Date:     "LATEST",
+				Select:   []string{"entity", "variable", "date", "value"},
+			},
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"test_var_2": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"geoId/05": {
+								OrderedFacets: []*pbv2.FacetObservation{
+									{
+										FacetId:      customFacetId,
+										Observations: []*pb.PointStat{{Date: "2020", Value: proto.Float64(6000)}},
+										ObsCount:     1,
+										EarliestDate: "2020",
+										LatestDate:   "2020",
+									},
+								},
+							},
+							"fake_entity": {},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{customFacetId: customFacet},
+			},
+		},
+		{
+			name: "facet filter",
+			req: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"var_with_props"}},
+				Entity:   &pbv2.DcidOrExpression{Dcids: []string{"country/USA"}},
+				Select:   []string{"entity", "variable", "date", "value"},
+				Filter:   &pbv2.FacetFilter{FacetIds: []string{usdFacetId}},
+			},
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"var_with_props": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"country/USA": {
+								OrderedFacets: []*pbv2.FacetObservation{
+									{
+										FacetId:      usdFacetId,
+										Observations: []*pb.PointStat{{Date: "2023", Value: proto.Float64(1)}},
+										ObsCount:     1,
+										EarliestDate: "2023",
+										LatestDate:   "2023",
+									},
+								},
+							},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{usdFacetId: usdFacet},
+			},
+		},
+		{
+			name: "contained in specific date",
+			req: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"test_var_2"}},
+				Entity:   &pbv2.DcidOrExpression{Expression: "State<-containedInPlace+{typeOf:State}"},
+				Date:     "2010",
+				Select:   []string{"entity", "variable", "date", "value"},
+			},
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"test_var_2": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"geoId/05": {
+								OrderedFacets: []*pbv2.FacetObservation{
+									{
+										FacetId:      customFacetId,
+										Observations: []*pb.PointStat{{Date: "2010", Value: proto.Float64(5000)}},
+										ObsCount:     1,
+										EarliestDate: "2010",
+										LatestDate:   "2010",
+									},
+								},
+							},
+							"geoId/06": {
+								OrderedFacets: []*pbv2.FacetObservation{
+									{
+										FacetId:      customFacetId,
+										Observations: []*pb.PointStat{{Date: "2010", Value: proto.Float64(1000)}},
+										ObsCount:     1,
+										EarliestDate: "2010",
+										LatestDate:   "2010",
+									},
+								},
+							},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{customFacetId: customFacet},
+			},
+		},
+		{
+			name: "contained in specific date with value filter",
+			req: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"test_var_2"}},
+				Entity:   &pbv2.DcidOrExpression{Expression: "State<-containedInPlace+{typeOf:State}"},
+				Date:     "2010",
+				Value:    ">1000",
+				Select:   []string{"entity", "variable", "date", "value"},
+			},
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"test_var_2": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"geoId/05": {
+								OrderedFacets: []*pbv2.FacetObservation{
+									{
+										FacetId:      customFacetId,
+										Observations: []*pb.PointStat{{Date: "2010", Value: proto.Float64(5000)}},
+										ObsCount:     1,
+										EarliestDate: "2010",
+										LatestDate:   "2010",
+									},
+								},
+							},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{customFacetId: customFacet},
+			},
+		},
+		{
+			name: "latest date with value filter",
+			req: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"test_var_2"}},
+				Entity:   &pbv2.DcidOrExpression{Dcids: []string{"geoId/05"}},
+				Date:     "LATEST",
+				Value:    "0..5000",
+				Select:   []string{"entity", "variable", "date", "value"},
+			},
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"test_var_2": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"geoId/05": {},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "all dates with value filter",
+			req: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"test_var_2"}},
+				Entity:   &pbv2.DcidOrExpression{Dcids: []string{"geoId/05", "geoId/06"}},
+				Value:    ">=2000",
+				Select:   []string{"entity", "variable", "date", "value"},
+			},
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"test_var_2": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"geoId/05": {
+								OrderedFacets: []*pbv2.FacetObservation{
+									{
+										FacetId: customFacetId,
+										Observations: []*pb.PointStat{
+											{Date: "2010", Value: proto.Float64(5000)},
+											{Date: "2020", Value: proto.Float64(6000)},
+										},
+										ObsCount:     2,
+										EarliestDate: "2010",
+										LatestDate:   "2020",
+									},
+								},
+							},
+							"geoId/06": {
+								OrderedFacets: []*pbv2.FacetObservation{
+									{
+										FacetId:      customFacetId,
+										Observations: []*pb.PointStat{{Date: "2020", Value: proto.Float64(2000)}},
+										ObsCount:     1,
+										EarliestDate: "2020",
+										LatestDate:   "2020",
+									},
+								},
+							},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{customFacetId: customFacet},
+			},
+		},
+		{
+			name: "contained in all dates with value filter",
+			req: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"test_var_2"}},
+				Entity:   &pbv2.DcidOrExpression{Expression: "State<-containedInPlace+{typeOf:State}"},
+				Value:    "<2000",
+				Select:   []string{"entity", "variable", "date", "value"},
+			},
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"test_var_2": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"geoId/06": {
+								OrderedFacets: []*pbv2.FacetObservation{
+									{
+										FacetId:      customFacetId,
+										Observations: []*pb.PointStat{{Date: "2010", Value: proto.Float64(1000)}},
+										ObsCount:     1,
+										EarliestDate: "2010",
+										LatestDate:   "2010",
+									},
+								},
+							},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{customFacetId: customFacet},
+			},
+		},
+		{
+			name: "contained in latest date with value filter",
+			req: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"test_var_2"}},
+				Entity:   &pbv2.DcidOrExpression{Expression: "State<-containedInPlace+{typeOf:State}"},
+				Date:     "LATEST",
+				Value:    "<6000",
+				Select:   []string{"entity", "variable", "date", "value"},
+			},
+			// The latest observation of geoId/05 doesn't match, so its earlier matching one isn't returned.
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"test_var_2": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"geoId/05": {},
+							"geoId/06": {
+								OrderedFacets: []*pbv2.FacetObservation{
+									{
+										FacetId:      customFacetId,
+										Observations: []*pb.PointStat{{Date: "2020", Value: proto.Float64(2000)}},
+										ObsCount:     1,
+										EarliestDate: "2020",
+										LatestDate:   "2020",
+									},
+								},
+							},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{customFacetId: customFacet},
+			},
+		},
+		{
+			name: "contained in facet",
+			req: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"test_var_2"}},
+				Entity:   &pbv2.DcidOrExpression{Expression: "State<-containedInPlace+{typeOf:State}"},
+				Select:   []string{"entity", "variable", "facet"},
+			},
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"test_var_2": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"": {
+								OrderedFacets: []*pbv2.FacetObservation{
+									{
+										FacetId:      customFacetId,
+										ObsCount:     2,
+										EarliestDate: "2010",
+										LatestDate:   "2020",
+									},
+								},
+							},
+						},
+					},
+				},
+				Facets: map[string]*pb.Facet{customFacetId: customFacet},
+			},
+		},
+		{
+			name: "existence",
+			req: &pbv2.ObservationRequest{
+				Variable: &pbv2.DcidOrExpression{Dcids: []string{"test_var_2", "test_var_3"}},
+				Entity:   &pbv2.DcidOrExpression{Dcids: []string{"ein/1", "ein/3", "geoId/06"}},
+				Select:   []string{"entity", "variable"},
+			},
+			want: &pbv2.ObservationResponse{
+				ByVariable: map[string]*pbv2.VariableObservation{
+					"test_var_2": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"ein/1":    {},
+							"geoId/06": {},
+						},
+					},
+					"test_var_3": {
+						ByEntity: map[string]*pbv2.EntityObservation{
+							"ein/1": {},
+							"ein/3": {},
+						},
+					},
+				},
+			},
+		},
+	} {
+		got, err := ds.Observation(context.Background(), tc.req)
+		if err != nil {
+			t.Fatalf("Observation error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
+
+// fakeChildPlacesDataSource is a secondary data source that returns one child place per Node page.
+type fakeChildPlacesDataSource struct {
+	datasource.DataSource
+	children []string
+}
+
+func (ds *fakeChildPlacesDataSource) Node(ctx context.Context, req *pbv2.NodeRequest) (*pbv2.NodeResponse, error) {
+	page := 0
+	if req.GetNextToken() != "" {
+		page = int(req.GetNextToken()[0] - '0')
+	}
+	resp := &pbv2.NodeResponse{Data: map[string]*pbv2.LinkedGraph{}}
+	for _, node := range req.GetNodes() {
+		resp.Data[node] = &pbv2.LinkedGraph{Arcs: map[string]*pbv2.Nodes{
+			"containedInPlace+": {Nodes: []*pb.EntityInfo{{Dcid: ds.children[page]}}},
+		}}
+	}
+	if page+1 < len(ds.children) {
+		resp.NextToken = string(rune('0' + page + 1))
+	}
+	return resp, nil
+}
+
+func TestObservationSecondaryChildPlaces(t *testing.T) {
+	sqlClient, err := NewSQLiteClient("../../test/datacommons.db")
+	if err != nil {
+		t.Fatalf("Could not open test database: %v", err)
+	}
+	// The child places of country/USA aren't in SQL, they're read from every page of the secondary data source.
+	ds, err := NewSQLDataSource(sqlClient, &fakeChildPlacesDataSource{children: []string{"geoId/05", "geoId/06"}})
+	if err != nil {
+		t.Fatalf("Could not create SQL data source: %v", err)
+	}
+
+	got, err := ds.Observation(context.Background(), &pbv2.ObservationRequest{
+		Variable: &pbv2.DcidOrExpression{Dcids: []string{"test_var_2"}},
+		Entity:   &pbv2.DcidOrExpression{Expression: "country/USA<-containedInPlace+{typeOf:State}"},
+		Date:     "2010",
+		Select:   []string{"entity", "variable"},
+	})
+	if err != nil {
+		t.Fatalf("Observation error: %v", err)
+	}
+	want := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{
+			"test_var_2": {
+				ByEntity: map[string]*pbv2.EntityObservation{
+					"geoId/05": {},
+					"geoId/06": {},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("Unexpected diff %v", diff)
+	}
+}
+
+func TestNode(t *testing.T) {
+	sqlClient, err := NewSQLiteClient("../../test/datacommons.db")
+	if err != nil {
+		t.Fatalf("Could not open test database: %v", err)
+	}
+	ds, err := NewSQLDataSource(sqlClient, nil)
+	if err != nil {
+		t.Fatalf("Could not create SQL data source: %v", err)
+	}
+	cmpOpts := cmp.Options{
+		protocmp.Transform(),
+		protocmp.SortRepeated(func(a, b *pb.EntityInfo) bool { return a.GetDcid() < b.GetDcid() }),
+	}
+
+	for _, tc := range []struct {
+		name string
+		req  *pbv2.NodeRequest
+		want *pbv2.NodeResponse
+	}{
+		{
+			name: "chained out arc",
+			req: &pbv2.NodeRequest{
+				Nodes:    []string{"dc/g/SQLite"},
+				Property: "->specializationOf+",
+			},
+			want: &pbv2.NodeResponse{
+				Data: map[string]*pbv2.LinkedGraph{
+					"dc/g/SQLite": {
+						Arcs: map[string]*pbv2.Nodes{
+							"specializationOf+": {
+								Nodes: []*pb.EntityInfo{
+									{Dcid: "dc/g/Root", Types: []string{"Thing"}},
+									{Dcid: "dc/g/SQL", Name: "SQL stat var group", Types: []string{"StatVarGroup"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "filtered in arc",
+			req: &pbv2.NodeRequest{
+				Nodes:    []string{"Earth"},
+				Property: "<-containedInPlace{typeOf:Country}",
+			},
+			want: &pbv2.NodeResponse{
+				Data: map[string]*pbv2.LinkedGraph{
+					"Earth": {
+						Arcs: map[string]*pbv2.Nodes{
+							"containedInPlace": {
+								Nodes: []*pb.EntityInfo{
+									{Dcid: "fire_country", Types: []string{"Country"}},
+									{Dcid: "water_country", Types: []string{"Country"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "multiple arcs",
+			req: &pbv2.NodeRequest{
+				Nodes:    []string{"test_var_1", "test_var_2"},
+				Property: "->memberOf->name",
+			},
+			want: &pbv2.NodeResponse{
+				Data: map[string]*pbv2.LinkedGraph{
+					"test_var_1": {
+						Arcs: map[string]*pbv2.Nodes{
+							"name": {Nodes: []*pb.EntityInfo{{Value: "SQLite stat var group", Types: []string{"Thing"}}}},
+						},
+					},
+					"test_var_2": {
+						Arcs: map[string]*pbv2.Nodes{
+							"name": {Nodes: []*pb.EntityInfo{{Value: "SQLite stat var group", Types: []string{"Thing"}}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "chained in arc and out arc",
+			req: &pbv2.NodeRequest{
+				Nodes:    []string{"dc/g/Root"},
+				Property: "<-specializationOf+->typeOf",
+			},
+			want: &pbv2.NodeResponse{
+				Data: map[string]*pbv2.LinkedGraph{
+					"dc/g/Root": {
+						Arcs: map[string]*pbv2.Nodes{
+							"typeOf": {Nodes: []*pb.EntityInfo{{Dcid: "StatVarGroup", Types: []string{"Thing"}}}},
+						},
+					},
+				},
+			},
+		},
+	} {
+		got, err := ds.Node(context.Background(), tc.req)
+		if err != nil {
+			t.Fatalf("Node error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want, cmpOpts); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
+
+func TestNodeSearch(t *testing.T) {
+	for _, useSearchIndex := range []bool{false, true} {
+		sqlClient, err := NewSQLiteClient("../../test/datacommons.db")
+		if err != nil {
+			t.Fatalf("Could not open test database: %v", err)
+		}
+		if useSearchIndex {
+			if err := sqlClient.buildSearchIndex(); err != nil {
+				t.Fatalf("Could not build search index: %v", err)
+			}
+		}
+		ds, err := NewSQLDataSource(sqlClient, nil)
+		if err != nil {
+			t.Fatalf("Could not create SQL data source: %v", err)
+		}
+
+		for _, tc := range []struct {
+			name string
+			req  *pbv2.NodeSearchRequest
+			want *pbv2.NodeSearchResponse
+		}{
+			{
+				name: "name",
+				req:  &pbv2.NodeSearchRequest{Query: "sql joins"},
+				want: &pbv2.NodeSearchResponse{
+					Results: []*pbv2.NodeSearchResult{
+						{
+							Node:  &pb.EntityInfo{Dcid: "test_var_2", Name: "total number of sql joins", Types: []string{"StatisticalVariable"}},
+							Match: &pb.PropertyValue{Property: "name", Value: "total number of sql joins"},
+						},
+					},
+				},
+			},
+			{
+				name: "predicates",
+				req:  &pbv2.NodeSearchRequest{Query: "entire database", Predicates: []string{"description"}},
+				want: &pbv2.NodeSearchResponse{
+					Results: []*pbv2.NodeSearchResult{
+						{
+							Node:  &pb.EntityInfo{Dcid: "test_var_1", Name: "total number of sql query used", Types: []string{"StatisticalVariable"}},
+							Match: &pb.PropertyValue{Property: "description", Value: "this is the count of sql query for the entire database"},
+						},
+					},
+				},
+			},
+			{
+				name: "types with exact match first",
+				req:  &pbv2.NodeSearchRequest{Query: "SQL stat var group", Types: []string{"StatVarGroup"}},
+				want: &pbv2.NodeSearchResponse{
+					Results: []*pbv2.NodeSearchResult{
+						{
+							Node:  &pb.EntityInfo{Dcid: "dc/g/SQL", Name: "SQL stat var group", Types: []string{"StatVarGroup"}},
+							Match: &pb.PropertyValue{Property: "name", Value: "SQL stat var group"},
+						},
+						{
+							Node:  &pb.EntityInfo{Dcid: "dc/g/SQLite", Name: "SQLite stat var group", Types: []string{"StatVarGroup"}},
+							Match: &pb.PropertyValue{Property: "name", Value: "SQLite stat var group"},
+						},
+					},
+				},
+			},
+			{
+				name: "types filtered out",
+				req:  &pbv2.NodeSearchRequest{Query: "sql joins", Types: []string{"Provenance"}},
+				want: &pbv2.NodeSearchResponse{},
+			},
+			{
+				name: "special characters",
+				req:  &pbv2.NodeSearchRequest{Query: `"sql" 100%`},
+				want: &pbv2.NodeSearchResponse{},
+			},
+		} {
+			got, err := ds.NodeSearch(context.Background(), tc.req)
+			if err != nil {
+				t.Fatalf("NodeSearch error (%s, search index: %t): %v", tc.name, useSearchIndex, err)
+			}
+			if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+				t.Errorf("Unexpected diff (%s, search index: %t) %v", tc.name, useSearchIndex, diff)
+			}
+		}
+	}
+}
+
+// fakeResolveDataSource is a secondary data source that resolves every node to a fixed candidate.
+type fakeResolveDataSource struct {
+	datasource.DataSource
+	candidate string
+}
+
+func (ds *fakeResolveDataSource) Resolve(ctx context.Context, req *pbv2.ResolveRequest) (*pbv2.ResolveResponse, error) {
+	resp := &pbv2.ResolveResponse{}
+	for _, node := range req.GetNodes() {
+		resp.Entities = append(resp.Entities, &pbv2.ResolveResponse_Entity{
+			Node:        node,
+			ResolvedIds: []string{ds.candidate},
+			Candidates:  []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: ds.candidate}},
+		})
+	}
+	return resp, nil
+}
+
+func TestResolve(t *testing.T) {
+	sqlClient, err := NewSQLiteClient("../../test/datacommons.db")
+	if err != nil {
+		t.Fatalf("Could not open test database: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		secondary datasource.DataSource
+		req       *pbv2.ResolveRequest
+		want      *pbv2.ResolveResponse
+	}{
+		{
+			name: "id to dcid",
+			req: &pbv2.ResolveRequest{
+				Nodes:    []string{"custom.datacommons.org", "foo.org"},
+				Property: "<-url->dcid",
+			},
+			want: &pbv2.ResolveResponse{
+				Entities: []*pbv2.ResolveResponse_Entity{
+					{
+						Node:        "custom.datacommons.org",
+						ResolvedIds: []string{"custom"},
+						Candidates:  []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "custom", DominantType: "Provenance"}},
+					},
+					{
+						Node:       "foo.org",
+						Candidates: []*pbv2.ResolveResponse_Entity_Candidate{},
+					},
+				},
+			},
+		},
+		{
+			name: "id to property",
+			req: &pbv2.ResolveRequest{
+				Nodes:    []string{"custom.datacommons.org"},
+				Property: "<-url->name",
+			},
+			want: &pbv2.ResolveResponse{
+				Entities: []*pbv2.ResolveResponse_Entity{
+					{
+						Node:        "custom.datacommons.org",
+						ResolvedIds: []string{"Custom Prov"},
+						Candidates:  []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "Custom Prov"}},
+					},
+				},
+			},
+		},
+		{
+			name: "description with type",
+			req: &pbv2.ResolveRequest{
+				Nodes:    []string{"sql stat var group", "total number of sql joins"},
+				Property: "<-description{typeOf:StatVarGroup}->dcid",
+			},
+			want: &pbv2.ResolveResponse{
+				Entities: []*pbv2.ResolveResponse_Entity{
+					{
+						Node:        "sql stat var group",
+						ResolvedIds: []string{"dc/g/SQL"},
+						Candidates:  []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "dc/g/SQL", DominantType: "StatVarGroup"}},
+					},
+					{
+						Node:       "total number of sql joins",
+						Candidates: []*pbv2.ResolveResponse_Entity_Candidate{},
+					},
+				},
+			},
+		},
+		{
+			name:      "secondary fallback",
+			secondary: &fakeResolveDataSource{candidate: "geoId/06"},
+			req: &pbv2.ResolveRequest{
+				Nodes:    []string{"SQL stat var group", "California"},
+				Property: "<-description->dcid",
+			},
+			want: &pbv2.ResolveResponse{
+				Entities: []*pbv2.ResolveResponse_Entity{
+					{
+						Node:        "SQL stat var group",
+						ResolvedIds: []string{"dc/g/SQL"},
+						Candidates:  []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "dc/g/SQL", DominantType: "StatVarGroup"}},
+					},
+					{
+						Node:       "California",
+						Candidates: []*pbv2.ResolveResponse_Entity_Candidate{{Dcid: "geoId/06"}},
+					},
+				},
+			},
+		},
+	} {
+		ds, err := NewSQLDataSource(sqlClient, tc.secondary)
+		if err != nil {
+			t.Fatalf("Could not create SQL data source: %v", err)
+		}
+		got, err := ds.Resolve(context.Background(), tc.req)
+		if err != nil {
+			t.Fatalf("Resolve error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
+
+func TestSparql(t *testing.T) {
+	sqlClient, err := NewSQLiteClient("../../test/datacommons.db")
+	if err != nil {
+		t.Fatalf("Could not open test database: %v", err)
+	}
+	ds, err := NewSQLDataSource(sqlClient, nil)
+	if err != nil {
+		t.Fatalf("Could not create SQL data source: %v", err)
+	}
+
+	row := func(values ...string) *pb.QueryResponseRow {
+		r := &pb.QueryResponseRow{}
+		for _, v := range values {
+			r.Cells = append(r.Cells, &pb.QueryResponseCell{Value: v})
+		}
+		return r
+	}
+
+	for _, tc := range []struct {
+		name  string
+		query string
+		want  *pb.QueryResponse
+	}{
+		{
+			name: "join and order",
+			query: `SELECT ?dcid ?name
+				WHERE {
+					?var typeOf StatisticalVariable .
+					?var dcid ?dcid .
+					?var name ?name
+				}
+				ORDER BY DESC(?dcid)`,
+			want: &pb.QueryResponse{
+				Header: []string{"?dcid", "?name"},
+				Rows: []*pb.QueryResponseRow{
+					row("test_var_3", "non-place entity variable"),
+					row("test_var_2", "total number of sql joins"),
+					row("test_var_1", "total number of sql query used"),
+				},
+			},
+		},
+		{
+			name: "dcid and value filters",
+			query: `SELECT ?name
+				WHERE {
+					?var name ?name .
+					?var dcid ("test_var_1" "test_var_2" "custom") .
+					?var typeOf StatisticalVariable
+				}
+				ORDER BY ?name
+				LIMIT 1`,
+			want: &pb.QueryResponse{
+				Header: []string{"?name"},
+				Rows:   []*pb.QueryResponseRow{row("total number of sql joins")},
+			},
+		},
+	} {
+		got, err := ds.Sparql(context.Background(), &pb.SparqlRequest{Query: tc.query})
+		if err != nil {
+			t.Fatalf("Sparql error (%s): %v", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+			t.Errorf("Unexpected diff (%s) %v", tc.name, diff)
+		}
+	}
+}
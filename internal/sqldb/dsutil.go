@@ -18,16 +18,26 @@ package sqldb
 
 import (
 	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv1 "github.com/datacommonsorg/mixer/internal/proto/v1"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/datasource/dsutil"
+	v2 "github.com/datacommonsorg/mixer/internal/server/v2"
 	"github.com/datacommonsorg/mixer/internal/util"
 )
 
 const (
-	namePredicate   = "name"
-	typeOfPredicate = "typeOf"
-	subjectIdColumn = "subject_id"
-	objectIdColumn  = "object_id"
-	defaultType     = "Thing"
+	namePredicate             = "name"
+	typeOfPredicate           = "typeOf"
+	containedInPlacePredicate = "containedInPlace"
+	dcidProperty              = "dcid"
+	descriptionProperty       = "description"
+	geoCoordinateProperty     = "geoCoordinate"
+	affectedPlacePredicate    = "affectedPlace"
+	startDatePredicate        = "startDate"
+	chainDecorator            = "+"
+	subjectIdColumn           = "subject_id"
+	objectIdColumn            = "object_id"
+	defaultType               = "Thing"
 )
 
 type entityInfo struct {
@@ -79,6 +89,52 @@ func triplesToNodeResponse(nodeTriples []*Triple, entityInfoTriples []*Triple, d
 	return nodeResponse
 }
 
+// arcNodesToReachedNodes converts arc nodes to the nodes reached from each node.
+func arcNodesToReachedNodes(arcNodes []*ArcNode) map[string][]string {
+	reached := map[string][]string{}
+	for _, arcNode := range arcNodes {
+		if arcNode.ReachedID != "" {
+			reached[arcNode.Node] = append(reached[arcNode.Node], arcNode.ReachedID)
+		}
+	}
+	return reached
+}
+
+// arcNodesToNodeResponse converts the arc nodes of the last arc of a chain to a NodeResponse proto.
+// The response is keyed by the nodes the chain starts from, reached maps them to the nodes the last arc starts from.
+func arcNodesToNodeResponse(reached map[string][]string, arcNodes []*ArcNode, entityInfoTriples []*Triple) *pbv2.NodeResponse {
+	nodeResponse := &pbv2.NodeResponse{
+		Data: make(map[string]*pbv2.LinkedGraph),
+	}
+
+	entityInfos := toEntityInfos(entityInfoTriples)
+	arcNodesByNode := map[string][]*ArcNode{}
+	for _, arcNode := range arcNodes {
+		arcNodesByNode[arcNode.Node] = append(arcNodesByNode[arcNode.Node], arcNode)
+	}
+	for node, reachedNodes := range reached {
+		// Different reached nodes can lead to the same node.
+		seen := map[ArcNode]struct{}{}
+		for _, reachedNode := range reachedNodes {
+			for _, arcNode := range arcNodesByNode[reachedNode] {
+				key := ArcNode{Predicate: arcNode.Predicate, ReachedID: arcNode.ReachedID, ReachedValue: arcNode.ReachedValue}
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				entityInfo, ok := entityInfos[arcNode.ReachedID]
+				if !ok {
+					entityInfo = newEntityInfo()
+				}
+				triple := &Triple{Predicate: arcNode.Predicate, ObjectValue: arcNode.ReachedValue}
+				addNodeResponseNode(nodeResponse, triple, node, arcNode.ReachedID, entityInfo)
+			}
+		}
+	}
+
+	return nodeResponse
+}
+
 // toEntityInfos converts entity info triples (name and type triples) to entity info (name and type) objects.
 func toEntityInfos(entityInfoTriples []*Triple) map[string]*entityInfo {
 	entityInfos := map[string]*entityInfo{}
@@ -144,3 +200,211 @@ func nodePredicatesToNodeResponse(nodePredicates []*NodePredicate) *pbv2.NodeRes
 	}
 	return nodeResponse
 }
+
+// observationsToFacetSeries groups observation rows by variable, entity and facet.
+// The rows are expected to be sorted by date (ascending).
+// The provenance name and url of each facet are looked up from the provenances map.
+func observationsToFacetSeries(observations []*Observation, provenances map[string]*pb.Facet, date string, filter *pbv2.FacetFilter) []*dsutil.FacetSeries {
+	builder := dsutil.NewFacetSeriesBuilder(filter)
+	for _, observation := range observations {
+		facet := dsutil.ObservationFacet(
+			provenances[observation.Provenance],
+			observation.Unit,
+			observation.ScalingFactor,
+			observation.MeasurementMethod,
+			observation.ObservationPeriod,
+		)
+		builder.Add(observation.Variable, observation.Entity, observation.Date, observation.Value, facet)
+	}
+	return builder.Series(date)
+}
+
+// entityVariablesToObservationResponse converts entity variables rows to an existence ObservationResponse proto.
+func entityVariablesToObservationResponse(variables []string, entityVariables []*EntityVariables) *pbv2.ObservationResponse {
+	variablesByEntity := map[string][]string{}
+	for _, row := range entityVariables {
+		variablesByEntity[row.Entity] = append(variablesByEntity[row.Entity], row.Variables...)
+	}
+	return dsutil.EntityVariablesToObservationResponse(variables, variablesByEntity)
+}
+
+// subjectObjectsToSubjects returns the subject ids of (subject_id, object_id) rows.
+func subjectObjectsToSubjects(rows []*SubjectObject) []string {
+	subjects := []string{}
+	for _, row := range rows {
+		subjects = append(subjects, row.SubjectID)
+	}
+	return subjects
+}
+
+// searchNodesToNodeSearchResponse converts search nodes to a node search response.
+// Names and types of the matched nodes are populated from the entity info triples.
+func searchNodesToNodeSearchResponse(nodes []*SearchNode, entityInfoTriples []*Triple) *pbv2.NodeSearchResponse {
+	names := map[string]string{}
+	for _, row := range entityInfoTriples {
+		if row.Predicate == namePredicate {
+			names[row.SubjectID] = row.ObjectValue
+		}
+	}
+	types := toEntityTypes(entityInfoTriples)
+
+	response := &pbv2.NodeSearchResponse{}
+	for _, node := range nodes {
+		response.Results = append(response.Results, &pbv2.NodeSearchResult{
+			Node: &pb.EntityInfo{
+				Dcid:  node.SubjectID,
+				Name:  names[node.SubjectID],
+				Types: types[node.SubjectID],
+			},
+			Match: &pb.PropertyValue{
+				Property: node.Predicate,
+				Value:    node.ObjectValue,
+			},
+		})
+	}
+	return response
+}
+
+// searchNodesToDcids returns the unique subject dcids of search nodes.
+func searchNodesToDcids(nodes []*SearchNode) []string {
+	dcids := []string{}
+	seen := map[string]struct{}{}
+	for _, node := range nodes {
+		if _, ok := seen[node.SubjectID]; ok {
+			continue
+		}
+		seen[node.SubjectID] = struct{}{}
+		dcids = append(dcids, node.SubjectID)
+	}
+	return dcids
+}
+
+// toEntityTypes collects all types of each entity from entity info triples.
+func toEntityTypes(entityInfoTriples []*Triple) map[string][]string {
+	types := map[string][]string{}
+	for _, row := range entityInfoTriples {
+		if row.Predicate == typeOfPredicate {
+			types[row.SubjectID] = append(types[row.SubjectID], row.ObjectID)
+		}
+	}
+	return types
+}
+
+// candidatesToDcids returns the unique candidates of resolution candidates.
+func candidatesToDcids(candidates []*ResolutionCandidate) []string {
+	dcids := []string{}
+	seen := map[string]struct{}{}
+	for _, candidate := range candidates {
+		if _, ok := seen[candidate.Candidate]; ok {
+			continue
+		}
+		seen[candidate.Candidate] = struct{}{}
+		dcids = append(dcids, candidate.Candidate)
+	}
+	return dcids
+}
+
+// candidatesToResolveResponse converts resolution candidates to a resolve response with an entity for each node.
+// Dominant types of candidates are populated from the entity info triples.
+func candidatesToResolveResponse(nodes []string, candidates []*ResolutionCandidate, entityInfoTriples []*Triple) *pbv2.ResolveResponse {
+	types := toEntityTypes(entityInfoTriples)
+	nodeToCandidates := map[string][]*ResolutionCandidate{}
+	for _, candidate := range candidates {
+		nodeToCandidates[candidate.Node] = append(nodeToCandidates[candidate.Node], candidate)
+	}
+
+	response := &pbv2.ResolveResponse{}
+	seenNodes := map[string]struct{}{}
+	for _, node := range nodes {
+		if _, ok := seenNodes[node]; ok {
+			continue
+		}
+		seenNodes[node] = struct{}{}
+
+		entity := &pbv2.ResolveResponse_Entity{
+			Node:       node,
+			Candidates: []*pbv2.ResolveResponse_Entity_Candidate{},
+		}
+		seenCandidates := map[string]struct{}{}
+		for _, candidate := range nodeToCandidates[node] {
+			if _, ok := seenCandidates[candidate.Candidate]; ok {
+				continue
+			}
+			seenCandidates[candidate.Candidate] = struct{}{}
+			entity.ResolvedIds = append(entity.ResolvedIds, candidate.Candidate)
+			entity.Candidates = append(entity.Candidates, &pbv2.ResolveResponse_Entity_Candidate{
+				Dcid:         candidate.Candidate,
+				DominantType: v2.GetDominantType(types[candidate.Candidate]),
+			})
+		}
+		response.Entities = append(response.Entities, entity)
+	}
+
+	return response
+}
+
+// eventTriplesToEventResponse converts event triples to an event collection response.
+// Events that don't meet the filter are dropped.
+func eventTriplesToEventResponse(eventTriples []*Triple, filter *v2.EventFilter) *pbv2.EventResponse {
+	eventDcids := []string{}
+	events := map[string]*pbv1.EventCollection_Event{}
+	eventPropVals := map[string]map[string][]string{}
+	for _, row := range eventTriples {
+		event, ok := events[row.SubjectID]
+		if !ok {
+			event = &pbv1.EventCollection_Event{Dcid: row.SubjectID}
+			events[row.SubjectID] = event
+			eventPropVals[row.SubjectID] = map[string][]string{}
+			eventDcids = append(eventDcids, row.SubjectID)
+		}
+		switch row.Predicate {
+		case typeOfPredicate:
+		case affectedPlacePredicate:
+			event.Places = append(event.Places, row.ObjectID)
+		case startDatePredicate:
+			event.Dates = append(event.Dates, row.ObjectValue)
+		default:
+			value := row.ObjectValue
+			if row.ObjectID != "" {
+				value = row.ObjectID
+			}
+			eventPropVals[row.SubjectID][row.Predicate] = append(eventPropVals[row.SubjectID][row.Predicate], value)
+		}
+	}
+
+	collection := &pbv1.EventCollection{
+		Events:         []*pbv1.EventCollection_Event{},
+		ProvenanceInfo: map[string]*pbv1.EventCollection_ProvenanceInfo{},
+	}
+	for _, dcid := range eventDcids {
+		propVals := eventPropVals[dcid]
+		if !filter.Keep(propVals) {
+			continue
+		}
+		event := events[dcid]
+		for prop, vals := range propVals {
+			if event.PropVals == nil {
+				event.PropVals = map[string]*pbv1.EventCollection_ValList{}
+			}
+			event.PropVals[prop] = &pbv1.EventCollection_ValList{Vals: vals}
+		}
+		collection.Events = append(collection.Events, event)
+	}
+	return &pbv2.EventResponse{EventCollection: collection}
+}
+
+// rowsToQueryResponse converts rows of values to a query response.
+func rowsToQueryResponse(header []string, rows [][]string) *pb.QueryResponse {
+	response := &pb.QueryResponse{
+		Header: header,
+		Rows:   []*pb.QueryResponseRow{},
+	}
+	for _, row := range rows {
+		responseRow := &pb.QueryResponseRow{}
+		for _, value := range row {
+			responseRow.Cells = append(responseRow.Cells, &pb.QueryResponseCell{Value: value})
+		}
+		response.Rows = append(response.Rows, responseRow)
+	}
+	return response
+}
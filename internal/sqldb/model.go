@@ -43,6 +43,19 @@ type Triple struct {
 	ObjectValue string `db:"object_value"`
 }
 
+// SearchNode represents a triple whose object value matched a node search.
+type SearchNode struct {
+	SubjectID   string `db:"subject_id"`
+	Predicate   string `db:"predicate"`
+	ObjectValue string `db:"object_value"`
+}
+
+// ResolutionCandidate represents a candidate row returned for resolve queries.
+type ResolutionCandidate struct {
+	Node      string `db:"node"`
+	Candidate string `db:"candidate"`
+}
+
 // SVSummary represents a SV summary row.
 type SVSummary struct {
 	Variable        string      `db:"variable"`
@@ -108,6 +121,14 @@ type NodePredicate struct {
 	Predicate string `db:"predicate"`
 }
 
+// ArcNode represents a node or value reached from a node by an arc.
+type ArcNode struct {
+	Node         string `db:"node"`
+	Predicate    string `db:"predicate"`
+	ReachedID    string `db:"reached_id"`
+	ReachedValue string `db:"reached_value"`
+}
+
 // SubjectObject represents a row for (subject_id, object_id) pairs.
 type SubjectObject struct {
 	SubjectID string `db:"subject_id"`
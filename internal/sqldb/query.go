@@ -17,11 +17,17 @@ package sqldb
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/datacommonsorg/mixer/internal/merger"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	v2 "github.com/datacommonsorg/mixer/internal/server/v2"
+	"github.com/datacommonsorg/mixer/internal/translator/sparql"
 	"github.com/datacommonsorg/mixer/internal/util"
 	"github.com/jmoiron/sqlx"
 	"golang.org/x/sync/errgroup"
@@ -37,35 +43,53 @@ const (
 	// Chunk size for CTE (Common Table Expression) statements.
 	// Chunking avoids issues where certain dbs (like sqlite) can't handle a large number of items in a CTE.
 	cteChunkSize = 500
+	// Escape character used in LIKE patterns.
+	likeEscape = "!"
 )
 
+// Predicates searched by node search requests that don't specify any.
+var defaultSearchPredicates = []string{"name", "description"}
+
+// triplesTable is the table that Sparql queries are translated against.
+var triplesTable = &sparql.TripleTable{
+	Name: TableTriples,
+	Object: func(alias string) string {
+		return fmt.Sprintf("COALESCE(NULLIF(%[1]s.object_id, ''), %[1]s.object_value)", alias)
+	},
+	Param:     func(name string) string { return ":" + name },
+	ListParam: func(name string) string { return "(:" + name + ")" },
+}
+
 // GetObservations retrieves observations from SQL given a list of variables and entities and a date.
-func (sc *SQLClient) GetObservations(ctx context.Context, variables []string, entities []string, date string) ([]*Observation, error) {
+// Observations are also filtered by valueFilter, which can be nil, except for the latest date
+// since the latest observations are selected after the query.
+func (sc *SQLClient) GetObservations(ctx context.Context, variables []string, entities []string, date string, valueFilter *util.ValueFilter) ([]*Observation, error) {
 	defer util.TimeTrack(time.Now(), "SQL: GetObservations")
 	var observations []*Observation
 	if len(variables) == 0 || len(entities) == 0 {
 		return observations, nil
 	}
 
+	if date == latestDate {
+		valueFilter = nil
+	}
+	valueConditions, args := valueFilterConditions(valueFilter)
+	args["variables"] = variables
+	args["entities"] = entities
+
 	var stmt statement
 
 	switch {
 	case date != "" && date != latestDate:
+		args["date"] = date
 		stmt = statement{
-			query: statements.getObsByVariableEntityAndDate,
-			args: map[string]interface{}{
-				"variables": variables,
-				"entities":  entities,
-				"date":      date,
-			},
+			query: fmt.Sprintf(statements.getObsByVariableEntityAndDate, valueConditions),
+			args:  args,
 		}
 	default:
 		stmt = statement{
-			query: statements.getObsByVariableAndEntity,
-			args: map[string]interface{}{
-				"variables": variables,
-				"entities":  entities,
-			},
+			query: fmt.Sprintf(statements.getObsByVariableAndEntity, valueConditions),
+			args:  args,
 		}
 	}
 
@@ -82,7 +106,9 @@ func (sc *SQLClient) GetObservations(ctx context.Context, variables []string, en
 }
 
 // GetObservationsByEntityType retrieves observations from SQL given a list of variables and an entity type and a date.
-func (sc *SQLClient) GetObservationsByEntityType(ctx context.Context, variables []string, entityType string, date string) ([]*Observation, error) {
+// Observations are also filtered by valueFilter, which can be nil, except for the latest date
+// since the latest observations are selected after the query.
+func (sc *SQLClient) GetObservationsByEntityType(ctx context.Context, variables []string, entityType string, date string, valueFilter *util.ValueFilter) ([]*Observation, error) {
 	defer util.TimeTrack(time.Now(), "SQL: GetObservationsByEntityType")
 
 	var observations []*Observation
@@ -90,25 +116,26 @@ func (sc *SQLClient) GetObservationsByEntityType(ctx context.Context, variables
 		return observations, nil
 	}
 
+	if date == latestDate {
+		valueFilter = nil
+	}
+	valueConditions, args := valueFilterConditions(valueFilter)
+	args["variables"] = variables
+	args["entityType"] = entityType
+
 	var stmt statement
 
 	switch {
 	case date != "" && date != latestDate:
+		args["date"] = date
 		stmt = statement{
-			query: statements.getObsByVariableEntityTypeAndDate,
-			args: map[string]interface{}{
-				"variables":  variables,
-				"entityType": entityType,
-				"date":       date,
-			},
+			query: fmt.Sprintf(statements.getObsByVariableEntityTypeAndDate, valueConditions),
+			args:  args,
 		}
 	default:
 		stmt = statement{
-			query: statements.getObsByVariableAndEntityType,
-			args: map[string]interface{}{
-				"variables":  variables,
-				"entityType": entityType,
-			},
+			query: fmt.Sprintf(statements.getObsByVariableAndEntityType, valueConditions),
+			args:  args,
 		}
 	}
 
@@ -442,6 +469,22 @@ func (sc *SQLClient) GetAllProvenances(ctx context.Context) ([]*ProvenanceInfo,
 	return rows, nil
 }
 
+// GetProvenanceFacets returns the facets of all provenances in the DB, keyed by provenance id.
+func (sc *SQLClient) GetProvenanceFacets(ctx context.Context) (map[string]*pb.Facet, error) {
+	rows, err := sc.GetAllProvenances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	facets := map[string]*pb.Facet{}
+	for _, row := range rows {
+		facets[row.ID] = &pb.Facet{
+			ImportName:    row.Name,
+			ProvenanceUrl: row.URL,
+		}
+	}
+	return facets, nil
+}
+
 // GetEntityInfoTriples returns name and typeOf triples for the specified entities.
 func (sc *SQLClient) GetEntityInfoTriples(ctx context.Context, entities []string) ([]*Triple, error) {
 	defer util.TimeTrack(time.Now(), "SQL: GetEntityInfoTriples")
@@ -504,6 +547,98 @@ func (sc *SQLClient) GetNodeTriples(ctx context.Context, nodes []string, propert
 	return triples, nil
 }
 
+// GetArcNodes retrieves the nodes and values reached from the specified nodes by an arc.
+// Chained (+) arcs are followed recursively and arc filters are evaluated in the database.
+func (sc *SQLClient) GetArcNodes(ctx context.Context, nodes []string, arc *v2.Arc) ([]*ArcNode, error) {
+	defer util.TimeTrack(time.Now(), "SQL: GetArcNodes")
+	// Query in chunks to bound the number of parameters of each statement.
+	nodeChunks := chunkSlice(nodes, cteChunkSize)
+
+	errGroup, errCtx := errgroup.WithContext(ctx)
+	chunkArcNodes := make([][]*ArcNode, len(nodeChunks))
+	for i, chunk := range nodeChunks {
+		// Assign to local variables so they can be used in go routines.
+		i, chunk := i, chunk
+		errGroup.Go(func() error {
+			stmt, err := arcNodesStatement(chunk, arc)
+			if err != nil {
+				return err
+			}
+			var rows []*ArcNode
+			err = sc.queryAndCollect(errCtx, stmt, &rows)
+			if err != nil {
+				return err
+			}
+			chunkArcNodes[i] = rows
+			return nil
+		})
+	}
+
+	if err := errGroup.Wait(); err != nil {
+		return nil, err
+	}
+
+	arcNodes := []*ArcNode{}
+	for _, rows := range chunkArcNodes {
+		arcNodes = append(arcNodes, rows...)
+	}
+	if arc.Decorator != "" {
+		// Chained rows are keyed by the decorated property.
+		for _, arcNode := range arcNodes {
+			arcNode.Predicate = arc.SingleProp + arc.Decorator
+		}
+	}
+	return arcNodes, nil
+}
+
+// arcNodesStatement returns the statement that retrieves the nodes and values reached from the nodes by the arc.
+func arcNodesStatement(nodes []string, arc *v2.Arc) (statement, error) {
+	args := map[string]interface{}{
+		"nodes": nodes,
+	}
+
+	// Conditions appended to the query.
+	var sb strings.Builder
+	// The query and its column of reached nodes.
+	var query, column string
+	switch {
+	case arc.Decorator != "":
+		if arc.Decorator != chainDecorator || arc.SingleProp == "" || arc.SingleProp == v2.WILDCARD {
+			return statement{}, fmt.Errorf("chain expressions are only supported for a single property")
+		}
+		query, column = statements.getChainedInArcNodes, "reached_id"
+		if arc.Out {
+			query = statements.getChainedOutArcNodes
+		}
+		args["predicate"] = arc.SingleProp
+	default:
+		query, column = statements.getInArcNodes, subjectIdColumn
+		if arc.Out {
+			query, column = statements.getOutArcNodes, objectIdColumn
+		}
+		if _, predicates := arc.IsPropertyValuesArc(); len(predicates) > 0 {
+			sb.WriteString(statements.filterArcPredicates)
+			args["predicates"] = predicates
+		}
+	}
+
+	filterProps := []string{}
+	for prop := range arc.Filter {
+		filterProps = append(filterProps, prop)
+	}
+	sort.Strings(filterProps)
+	for i, prop := range filterProps {
+		sb.WriteString(fmt.Sprintf(statements.filterArcNodes, column, i))
+		args[fmt.Sprintf("filter_predicate_%d", i)] = prop
+		args[fmt.Sprintf("filter_values_%d", i)] = arc.Filter[prop]
+	}
+
+	return statement{
+		query: fmt.Sprintf(query, sb.String()),
+		args:  args,
+	}, nil
+}
+
 // getNodeChunkTriples retrieves triples from SQL for the specified node chunk and properties in the specified direction (in or out).
 func (sc *SQLClient) getNodeChunkTriples(ctx context.Context, nodeChunk []string, properties []string, direction string) ([]*Triple, error) {
 	defer util.TimeTrack(time.Now(), "SQL: getNodeChunkTriples")
@@ -599,27 +734,283 @@ func (sc *SQLClient) GetAllImports(ctx context.Context) ([]*ImportInfo, error) {
 	return rows, nil
 }
 
+// SearchObjectValues searches object values for the specified predicates based on the query and optionally the types.
+// If the types array is empty, it searches across nodes of all types.
+// The full-text search index is used if available, otherwise the triples table is searched with LIKE.
+// A maximum of 100 results are returned.
+func (sc *SQLClient) SearchObjectValues(ctx context.Context, query string, predicates []string, types []string) ([]*SearchNode, error) {
+	defer util.TimeTrack(time.Now(), "SQL: SearchObjectValues")
+	var rows []*SearchNode
+	tokens := strings.Fields(query)
+	if len(tokens) == 0 {
+		return rows, nil
+	}
+
+	if len(predicates) == 0 {
+		predicates = defaultSearchPredicates
+	}
+
+	stmt := statement{
+		args: map[string]interface{}{
+			"query":      query,
+			"predicates": predicates,
+		},
+	}
+
+	var db *sqlx.DB
+	if sc.searchIndex != nil {
+		db = sc.searchIndex
+		typesFilter := ""
+		if len(types) > 0 {
+			typesFilter = fmt.Sprintf(statements.filterTypes, "source.triples")
+			stmt.args["types"] = types
+		}
+		stmt.query = fmt.Sprintf(statements.searchObjectValuesFullText, typesFilter, merger.MAX_SEARCH_RESULTS)
+		stmt.args["match"] = toFullTextQuery(tokens)
+	} else {
+		db = sc.dbx
+		typesFilter := ""
+		if len(types) > 0 {
+			typesFilter = fmt.Sprintf(statements.filterTypes, TableTriples)
+			stmt.args["types"] = types
+		}
+		var tokenFilters strings.Builder
+		for i, token := range tokens {
+			param := fmt.Sprintf("token%d", i+1)
+			tokenFilters.WriteString(fmt.Sprintf("AND LOWER(object_value) LIKE :%s ESCAPE '%s' ", param, likeEscape))
+			stmt.args[param] = "%" + escapeLike(strings.ToLower(token)) + "%"
+		}
+		stmt.query = fmt.Sprintf(statements.searchObjectValuesLike, tokenFilters.String(), typesFilter, merger.MAX_SEARCH_RESULTS)
+		stmt.args["prefix"] = escapeLike(strings.ToLower(query)) + "%"
+	}
+
+	err := queryAndCollect(
+		ctx,
+		db,
+		stmt,
+		&rows,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// ResolveByID resolves nodes to values of the out property of subjects with matching in property values.
+// If the out property is dcid, the nodes are resolved to the matching subjects.
+func (sc *SQLClient) ResolveByID(ctx context.Context, nodes []string, inProp string, outProp string) ([]*ResolutionCandidate, error) {
+	defer util.TimeTrack(time.Now(), "SQL: ResolveByID")
+	var rows []*ResolutionCandidate
+	if len(nodes) == 0 {
+		return rows, nil
+	}
+
+	stmt := statement{
+		query: statements.resolveByID,
+		args: map[string]interface{}{
+			"nodes":  nodes,
+			"inProp": inProp,
+		},
+	}
+	if outProp != dcidProperty {
+		stmt.query = statements.resolveByIDToProperty
+		stmt.args["outProp"] = outProp
+	}
+
+	err := sc.queryAndCollect(
+		ctx,
+		stmt,
+		&rows,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// ResolveByName resolves names to the subjects with matching names and optionally the types.
+// Names are matched case insensitively.
+func (sc *SQLClient) ResolveByName(ctx context.Context, nodes []string, types []string) ([]*ResolutionCandidate, error) {
+	defer util.TimeTrack(time.Now(), "SQL: ResolveByName")
+	var rows []*ResolutionCandidate
+	if len(nodes) == 0 {
+		return rows, nil
+	}
+
+	// Map of lower case names to the requested nodes.
+	nameToNodes := map[string][]string{}
+	names := []string{}
+	for _, node := range nodes {
+		name := strings.ToLower(node)
+		if _, ok := nameToNodes[name]; !ok {
+			names = append(names, name)
+		}
+		nameToNodes[name] = append(nameToNodes[name], node)
+	}
+
+	stmt := statement{
+		query: fmt.Sprintf(statements.resolveByName, ""),
+		args: map[string]interface{}{
+			"nodes": names,
+		},
+	}
+	if len(types) > 0 {
+		stmt.query = fmt.Sprintf(statements.resolveByName, fmt.Sprintf(statements.filterTypes, TableTriples))
+		stmt.args["types"] = types
+	}
+
+	err := sc.queryAndCollect(
+		ctx,
+		stmt,
+		&rows,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []*ResolutionCandidate{}
+	for _, row := range rows {
+		for _, node := range nameToNodes[row.Node] {
+			candidates = append(candidates, &ResolutionCandidate{Node: node, Candidate: row.Candidate})
+		}
+	}
+	return candidates, nil
+}
+
+// GetEventTriples retrieves all triples of events of a type that affected a place in a month (YYYY-MM).
+func (sc *SQLClient) GetEventTriples(ctx context.Context, eventType string, place string, date string) ([]*Triple, error) {
+	defer util.TimeTrack(time.Now(), "SQL: GetEventTriples")
+	var rows []*Triple
+
+	stmt := statement{
+		query: statements.getEventTriples,
+		args: map[string]interface{}{
+			"eventType": eventType,
+			"place":     place,
+			"date":      escapeLike(date) + "%",
+		},
+	}
+
+	err := sc.queryAndCollect(
+		ctx,
+		stmt,
+		&rows,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// GetEventDates retrieves the months (YYYY-MM) with events of a type that affected a place.
+func (sc *SQLClient) GetEventDates(ctx context.Context, eventType string, place string) ([]string, error) {
+	defer util.TimeTrack(time.Now(), "SQL: GetEventDates")
+	var rows []string
+
+	stmt := statement{
+		query: statements.getEventDates,
+		args: map[string]interface{}{
+			"eventType": eventType,
+			"place":     place,
+		},
+	}
+
+	err := sc.queryAndCollect(
+		ctx,
+		stmt,
+		&rows,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// QueryTriples runs a Sparql query against the triples table.
+// It returns the selected node aliases and the values of each matching row.
+func (sc *SQLClient) QueryTriples(ctx context.Context, query string) ([]string, [][]string, error) {
+	defer util.TimeTrack(time.Now(), "SQL: QueryTriples")
+	translation, err := sparql.TranslateToTriples(query, triplesTable)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	boundQuery, args, err := bindStatement(sc.dbx, statement{query: translation.SQL, args: translation.Params})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := sc.dbx.QueryContext(ctx, boundQuery, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	result := [][]string{}
+	for rows.Next() {
+		cells := make([]sql.NullString, len(translation.Header))
+		dest := make([]interface{}, len(cells))
+		for i := range cells {
+			dest[i] = &cells[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, nil, err
+		}
+		values := make([]string, len(cells))
+		for i, cell := range cells {
+			values[i] = cell.String
+		}
+		result = append(result, values)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return translation.Header, result, nil
+}
+
 func (sc *SQLClient) queryAndCollect(
 	ctx context.Context,
 	stmt statement,
 	dest interface{},
 ) error {
+	return queryAndCollect(ctx, sc.dbx, stmt, dest)
+}
+
+func queryAndCollect(
+	ctx context.Context,
+	db *sqlx.DB,
+	stmt statement,
+	dest interface{},
+) error {
+	query, args, err := bindStatement(db, stmt)
+	if err != nil {
+		return err
+	}
+
+	return db.SelectContext(ctx, dest, query, args...)
+}
+
+// bindStatement converts a statement to a query with the driver's placeholders and its list of args.
+func bindStatement(db *sqlx.DB, stmt statement) (string, []interface{}, error) {
 	// Convert named query and maps of args to placeholder query and list of args.
 	query, args, err := sqlx.Named(stmt.query, stmt.args)
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
 	// Expand slice values.
 	query, args, err = sqlx.In(query, args...)
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
 	// Transform query to the driver's placeholder type.
-	query = sc.dbx.Rebind(query)
-
-	return sc.dbx.SelectContext(ctx, dest, query, args...)
+	return db.Rebind(query), args, nil
 }
 
 // ValidateDatabase checks if the SQL DB has all the tables and complies to the schema expected by the service.
@@ -635,6 +1026,46 @@ func (sc *SQLClient) ValidateDatabase() error {
 		return err
 	}
 
+	if sc.sqlitePath != "" {
+		// Search falls back to LIKE queries if the index cannot be built, so it is not a validation error.
+		err = sc.buildSearchIndex()
+		if err != nil {
+			log.Printf("Error building search index, falling back to LIKE search: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// buildSearchIndex builds an in-memory full-text (FTS5) index of triple object values in the SQLite DB.
+// The index is kept in a separate in-memory database so the DB file itself is never modified.
+func (sc *SQLClient) buildSearchIndex() error {
+	defer util.TimeTrack(time.Now(), "SQL: buildSearchIndex")
+	db, err := sqlx.Open(sqliteDriver, ":memory:")
+	if err != nil {
+		return err
+	}
+	// Each connection to an in-memory database is a separate database, so use a single connection.
+	db.SetMaxOpenConns(1)
+	db.SetConnMaxLifetime(0)
+	db.SetConnMaxIdleTime(0)
+
+	if _, err = db.NamedExec(statements.attachSearchSource, map[string]interface{}{"path": sc.sqlitePath}); err != nil {
+		db.Close()
+		return err
+	}
+	for _, query := range []string{statements.createSearchIndex, statements.populateSearchIndex} {
+		if _, err = db.Exec(query); err != nil {
+			db.Close()
+			return err
+		}
+	}
+
+	if sc.searchIndex != nil {
+		sc.searchIndex.Close()
+	}
+	sc.searchIndex = db
+	log.Printf("SQL search index built.")
 	return nil
 }
 
@@ -743,6 +1174,53 @@ func chunkSlice(items []string, chunkSize int) [][]string {
 	return chunks
 }
 
+// toFullTextQuery converts search tokens to an FTS5 query that prefix matches all tokens.
+// Tokens are quoted so FTS5 operators in the query are searched literally.
+func toFullTextQuery(tokens []string) string {
+	terms := []string{}
+	for _, token := range tokens {
+		terms = append(terms, fmt.Sprintf(`"%s"*`, strings.ReplaceAll(token, `"`, `""`)))
+	}
+	return strings.Join(terms, " ")
+}
+
+// escapeLike escapes LIKE wildcards in the value using likeEscape.
+func escapeLike(value string) string {
+	return strings.NewReplacer(
+		likeEscape, likeEscape+likeEscape,
+		"%", likeEscape+"%",
+		"_", likeEscape+"_",
+	).Replace(value)
+}
+
+// valueFilterConditions returns the conditions of a value filter on the values of observations, and their named args.
+// Values are stored as text and are converted to numbers by adding 0, which works with both sqlite and mysql.
+// The conditions are empty if the filter is nil.
+func valueFilterConditions(filter *util.ValueFilter) (string, map[string]interface{}) {
+	args := map[string]interface{}{}
+	if filter == nil {
+		return "", args
+	}
+	var conditions []string
+	if filter.Min != nil {
+		op := ">="
+		if filter.MinExclusive {
+			op = ">"
+		}
+		conditions = append(conditions, fmt.Sprintf("AND (value + 0) %s :valueMin", op))
+		args["valueMin"] = *filter.Min
+	}
+	if filter.Max != nil {
+		op := "<="
+		if filter.MaxExclusive {
+			op = "<"
+		}
+		conditions = append(conditions, fmt.Sprintf("AND (value + 0) %s :valueMax", op))
+		args["valueMax"] = *filter.Max
+	}
+	return strings.Join(conditions, " "), args
+}
+
 // statement struct includes the sql query and named args used to execute a sql query.
 type statement struct {
 	query string
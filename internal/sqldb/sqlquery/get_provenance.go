@@ -23,18 +23,5 @@ import (
 
 // GetProvenances returns all the provenance name and url in SQL database.
 func GetProvenances(ctx context.Context, sqlClient *sqldb.SQLClient) (map[string]*pb.Facet, error) {
-	result := map[string]*pb.Facet{}
-	rows, err := sqlClient.GetAllProvenances(ctx)
-	if err != nil {
-		return nil, err
-	}
-	// Process the query result
-	for _, row := range rows {
-		var id, name, url = row.ID, row.Name, row.URL
-		result[id] = &pb.Facet{
-			ImportName:    name,
-			ProvenanceUrl: url,
-		}
-	}
-	return result, nil
+	return sqlClient.GetProvenanceFacets(ctx)
 }
@@ -40,7 +40,7 @@ func GetObservations(
 	}
 
 	// Query SQL.
-	obsRows, err := sqlClient.GetObservations(ctx, variables, entities, queryDate)
+	obsRows, err := sqlClient.GetObservations(ctx, variables, entities, queryDate, nil)
 	if err != nil {
 		return nil, err
 	}
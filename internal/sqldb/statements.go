@@ -38,8 +38,25 @@ var statements = struct {
 	getEntityInfoTriples                      string
 	getSubjectTriples                         string
 	getObjectTriples                          string
+	getOutArcNodes                            string
+	getInArcNodes                             string
+	getChainedOutArcNodes                     string
+	getChainedInArcNodes                      string
+	filterArcPredicates                       string
+	filterArcNodes                            string
 	getAllProvenances                         string
 	getAllImports                             string
+	attachSearchSource                        string
+	createSearchIndex                         string
+	populateSearchIndex                       string
+	searchObjectValuesFullText                string
+	searchObjectValuesLike                    string
+	filterTypes                               string
+	resolveByID                               string
+	resolveByIDToProperty                     string
+	resolveByName                             string
+	getEventTriples                           string
+	getEventDates                             string
 }{
 	getObsByVariableAndEntity: `
 		SELECT entity, variable, date, value, provenance, unit, scaling_factor, measurement_method, observation_period, properties 
@@ -48,6 +65,7 @@ var statements = struct {
 			entity IN (:entities)
 			AND variable IN (:variables)
 			AND value != ''
+			%s
 		ORDER BY date ASC;
 	`,
 	getObsByVariableEntityAndDate: `
@@ -58,6 +76,7 @@ var statements = struct {
 			AND variable IN (:variables)
 			AND value != ''
 			AND date = :date
+			%s
 		ORDER BY date ASC;
 	`,
 	getObsByVariableAndEntityType: `
@@ -70,6 +89,7 @@ var statements = struct {
 		) AS t ON o.entity = t.subject_id
 		WHERE o.value != ''
 		AND o.variable IN (:variables)
+		%s
 		ORDER BY date ASC;
 	`,
 	getObsByVariableEntityTypeAndDate: `
@@ -83,6 +103,7 @@ var statements = struct {
 		WHERE o.value != ''
 		AND o.variable IN (:variables)
 		AND o.date = :date
+		%s
 		ORDER BY date ASC;
 	`,
 	getStatVarSummaries: `
@@ -284,6 +305,54 @@ var statements = struct {
 		INNER JOIN triples t ON a.node = t.object_id AND a.prop = t.predicate
 		GROUP BY a.node, a.prop, subject_id, predicate, object_id, object_value;
 	`,
+	getOutArcNodes: `
+		SELECT subject_id node, predicate, COALESCE(object_id, '') reached_id, COALESCE(object_value, '') reached_value
+		FROM triples
+		WHERE subject_id IN (:nodes)%s;
+	`,
+	getInArcNodes: `
+		SELECT object_id node, predicate, subject_id reached_id, '' reached_value
+		FROM triples
+		WHERE object_id IN (:nodes)%s;
+	`,
+	// Chained arcs are followed recursively. UNION drops duplicate rows, which also stops at cycles.
+	getChainedOutArcNodes: `
+		WITH RECURSIVE arc_nodes(node, reached_id) AS (
+			SELECT subject_id, object_id
+			FROM triples
+			WHERE subject_id IN (:nodes) AND predicate = :predicate
+			UNION
+			SELECT a.node, t.object_id
+			FROM arc_nodes a
+			INNER JOIN triples t ON t.subject_id = a.reached_id AND t.predicate = :predicate
+		)
+		SELECT node, reached_id
+		FROM arc_nodes
+		WHERE reached_id != ''%s;
+	`,
+	getChainedInArcNodes: `
+		WITH RECURSIVE arc_nodes(node, reached_id) AS (
+			SELECT object_id, subject_id
+			FROM triples
+			WHERE object_id IN (:nodes) AND predicate = :predicate
+			UNION
+			SELECT a.node, t.subject_id
+			FROM arc_nodes a
+			INNER JOIN triples t ON t.object_id = a.reached_id AND t.predicate = :predicate
+		)
+		SELECT node, reached_id
+		FROM arc_nodes
+		WHERE reached_id != ''%s;
+	`,
+	filterArcPredicates: `
+		AND predicate IN (:predicates)`,
+	// Filters the reached nodes, in the column of the first argument, by a property value.
+	filterArcNodes: `
+		AND %[1]s IN (
+			SELECT subject_id
+			FROM triples
+			WHERE predicate = :filter_predicate_%[2]d AND (object_id IN (:filter_values_%[2]d) OR object_value IN (:filter_values_%[2]d))
+		)`,
 	getAllProvenances: `
 		SELECT t1.subject_id provenance_id, t2.object_value provenance_name, t3.object_value provenance_url
 		FROM 
@@ -304,4 +373,109 @@ var statements = struct {
 		ORDER BY imported_at DESC
 		LIMIT 100;
 	`,
+	// Attaches the SQLite database file to the in-memory search index connection.
+	attachSearchSource: `
+		ATTACH DATABASE :path AS source;
+	`,
+	createSearchIndex: `
+		CREATE VIRTUAL TABLE search_index USING fts5(subject_id UNINDEXED, predicate UNINDEXED, object_value);
+	`,
+	populateSearchIndex: `
+		INSERT INTO search_index(subject_id, predicate, object_value)
+		SELECT subject_id, predicate, object_value
+		FROM source.triples
+		WHERE object_value IS NOT NULL AND object_value != '';
+	`,
+	// Searches the full-text index. The types filter and result limit must be added via string interpolation.
+	// Exact matches are ranked first followed by the FTS5 (bm25) rank.
+	searchObjectValuesFullText: `
+		SELECT subject_id, predicate, object_value
+		FROM search_index
+		WHERE
+			search_index MATCH :match
+			AND predicate IN (:predicates)
+			%s
+		ORDER BY LOWER(object_value) = LOWER(:query) DESC, rank, subject_id
+		LIMIT %d;
+	`,
+	// Searches the triples table with LIKE. The token filters, types filter and result limit must be added via string interpolation.
+	// Exact matches are ranked first followed by prefix matches and shorter values.
+	searchObjectValuesLike: `
+		SELECT subject_id, predicate, object_value
+		FROM triples
+		WHERE
+			predicate IN (:predicates)
+			%s
+			%s
+		ORDER BY LOWER(object_value) = LOWER(:query) DESC, LOWER(object_value) LIKE :prefix ESCAPE '!' DESC, LENGTH(object_value), subject_id
+		LIMIT %d;
+	`,
+	// Subquery to filter subjects by types. The triples table name must be added via string interpolation.
+	filterTypes: `
+		AND subject_id IN (
+			SELECT subject_id
+			FROM %s
+			WHERE predicate = 'typeOf' AND object_id IN (:types)
+		)
+	`,
+	resolveByID: `
+		SELECT DISTINCT COALESCE(NULLIF(object_id, ''), object_value) node, subject_id candidate
+		FROM triples
+		WHERE
+			predicate = :inProp
+			AND (object_id IN (:nodes) OR object_value IN (:nodes))
+		ORDER BY node, candidate;
+	`,
+	resolveByIDToProperty: `
+		SELECT DISTINCT
+			COALESCE(NULLIF(t1.object_id, ''), t1.object_value) node,
+			COALESCE(NULLIF(t2.object_id, ''), t2.object_value) candidate
+		FROM
+			triples t1
+			JOIN triples t2 ON t1.subject_id = t2.subject_id
+		WHERE
+			t1.predicate = :inProp
+			AND (t1.object_id IN (:nodes) OR t1.object_value IN (:nodes))
+			AND t2.predicate = :outProp
+		ORDER BY node, candidate;
+	`,
+	// Names are matched case insensitively. The types filter must be added via string interpolation.
+	resolveByName: `
+		SELECT DISTINCT LOWER(object_value) node, subject_id candidate
+		FROM triples
+		WHERE
+			predicate = 'name'
+			AND LOWER(object_value) IN (:nodes)
+			%s
+		ORDER BY node, candidate;
+	`,
+	// Events are linked to the places they affect and matched by the month prefix of their start date.
+	getEventTriples: `
+		SELECT subject_id, predicate, object_id, object_value
+		FROM triples
+		WHERE subject_id IN (
+			SELECT e.subject_id
+			FROM
+				triples e
+				JOIN triples p ON e.subject_id = p.subject_id
+				JOIN triples d ON e.subject_id = d.subject_id
+			WHERE
+				e.predicate = 'typeOf' AND e.object_id = :eventType
+				AND p.predicate = 'affectedPlace' AND p.object_id = :place
+				AND d.predicate = 'startDate' AND d.object_value LIKE :date ESCAPE '!'
+		)
+		ORDER BY subject_id, predicate, object_id, object_value;
+	`,
+	getEventDates: `
+		SELECT DISTINCT SUBSTR(d.object_value, 1, 7) date
+		FROM
+			triples e
+			JOIN triples p ON e.subject_id = p.subject_id
+			JOIN triples d ON e.subject_id = d.subject_id
+		WHERE
+			e.predicate = 'typeOf' AND e.object_id = :eventType
+			AND p.predicate = 'affectedPlace' AND p.object_id = :place
+			AND d.predicate = 'startDate' AND d.object_value != ''
+		ORDER BY date;
+	`,
 }
@@ -0,0 +1,186 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/datacommonsorg/mixer/internal/translator/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dcidPredicate binds a node to its own dcid instead of to a triple.
+const dcidPredicate = "dcid"
+
+// TripleTable describes a table of (subject_id, predicate, object_id, object_value)
+// triples that a Sparql query can be translated against.
+type TripleTable struct {
+	// Name of the triples table.
+	Name string
+	// Object returns the expression for the object of the triple with the given table alias.
+	Object func(alias string) string
+	// Param returns the placeholder for a named scalar parameter.
+	Param func(name string) string
+	// ListParam returns the right hand side of an IN clause for a named list parameter.
+	ListParam func(name string) string
+}
+
+// TriplesQuery is a Sparql query translated to SQL over a TripleTable.
+type TriplesQuery struct {
+	SQL    string
+	Params map[string]interface{}
+	// Header holds the selected node aliases, in the order of the result columns.
+	Header []string
+}
+
+// TranslateToTriples translates a Sparql query into a SQL query over a triples table.
+//
+// Each triple pattern becomes one alias of the table. Nodes shared between
+// patterns become join conditions and constant objects become filters.
+func TranslateToTriples(queryString string, table *TripleTable) (*TriplesQuery, error) {
+	nodes, queries, opts, err := ParseQuery(queryString)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"no variable selected in sparql query\n%s", queryString)
+	}
+
+	params := map[string]interface{}{}
+	addParam := func(value interface{}) string {
+		name := fmt.Sprintf("p%d", len(params))
+		params[name] = value
+		return name
+	}
+
+	exprs := map[string]string{}
+	var tables, conditions []string
+	bind := func(node types.Node, expr string) {
+		if bound, ok := exprs[node.Alias]; ok {
+			conditions = append(conditions, fmt.Sprintf("%s = %s", bound, expr))
+			return
+		}
+		exprs[node.Alias] = expr
+	}
+	constrain := func(expr string, obj interface{}) error {
+		switch v := obj.(type) {
+		case string:
+			conditions = append(conditions,
+				fmt.Sprintf("%s = %s", expr, table.Param(addParam(unquote(v)))))
+		case []string:
+			values := make([]string, 0, len(v))
+			for _, s := range v {
+				values = append(values, unquote(s))
+			}
+			conditions = append(conditions,
+				fmt.Sprintf("%s IN %s", expr, table.ListParam(addParam(values))))
+		default:
+			return status.Errorf(codes.InvalidArgument,
+				"unsupported object in sparql query\n%s", queryString)
+		}
+		return nil
+	}
+
+	dcidQueries := []*types.Query{}
+	for _, q := range queries {
+		if q.Pred == dcidPredicate {
+			dcidQueries = append(dcidQueries, q)
+			continue
+		}
+		alias := fmt.Sprintf("t%d", len(tables))
+		tables = append(tables, fmt.Sprintf("%s %s", table.Name, alias))
+		conditions = append(conditions,
+			fmt.Sprintf("%s.predicate = %s", alias, table.Param(addParam(q.Pred))))
+		bind(q.Sub, alias+".subject_id")
+		if obj, ok := q.Obj.(types.Node); ok {
+			bind(obj, table.Object(alias))
+		} else if err := constrain(table.Object(alias), q.Obj); err != nil {
+			return nil, err
+		}
+	}
+
+	// dcid patterns only constrain nodes that are bound by other patterns.
+	for _, q := range dcidQueries {
+		expr, ok := exprs[q.Sub.Alias]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"node %s is only used with dcid in sparql query\n%s", q.Sub.Alias, queryString)
+		}
+		if obj, ok := q.Obj.(types.Node); ok {
+			bind(obj, expr)
+		} else if err := constrain(expr, q.Obj); err != nil {
+			return nil, err
+		}
+	}
+
+	header := []string{}
+	columns := []string{}
+	for i, node := range nodes {
+		expr, ok := exprs[node.Alias]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"selected node %s is not used in sparql query\n%s", node.Alias, queryString)
+		}
+		header = append(header, node.Alias)
+		columns = append(columns, fmt.Sprintf("%s AS c%d", expr, i))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	if opts.Distinct {
+		sb.WriteString("DISTINCT ")
+	}
+	sb.WriteString(strings.Join(columns, ", "))
+	sb.WriteString(" FROM ")
+	sb.WriteString(strings.Join(tables, ", "))
+	if len(conditions) > 0 {
+		sb.WriteString(" WHERE ")
+		sb.WriteString(strings.Join(conditions, " AND "))
+	}
+	if opts.Orderby != "" {
+		column := ""
+		for i, node := range nodes {
+			if node.Alias == opts.Orderby {
+				column = fmt.Sprintf("c%d", i)
+				break
+			}
+		}
+		if column == "" {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"order by node %s is not selected in sparql query\n%s", opts.Orderby, queryString)
+		}
+		sb.WriteString(" ORDER BY ")
+		sb.WriteString(column)
+		if !opts.ASC {
+			sb.WriteString(" DESC")
+		}
+	}
+	if opts.Limit > 0 {
+		sb.WriteString(fmt.Sprintf(" LIMIT %d", opts.Limit))
+	}
+
+	return &TriplesQuery{SQL: sb.String(), Params: params, Header: header}, nil
+}
+
+// unquote strips the quotes the parser keeps around string literals.
+func unquote(s string) string {
+	if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparql
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+func TestTranslateToTriples(t *testing.T) {
+	table := &TripleTable{
+		Name:      "triples",
+		Object:    func(alias string) string { return alias + ".object_id" },
+		Param:     func(name string) string { return ":" + name },
+		ListParam: func(name string) string { return "(:" + name + ")" },
+	}
+	for _, c := range []struct {
+		query   string
+		want    *TriplesQuery
+		wantErr bool
+	}{
+		{
+			`SELECT ?name WHERE {?state typeOf State . ?state name ?name}`,
+			&TriplesQuery{
+				SQL: "SELECT t1.object_id AS c0 FROM triples t0, triples t1 " +
+					"WHERE t0.predicate = :p0 AND t0.object_id = :p1 AND t1.predicate = :p2 AND t0.subject_id = t1.subject_id",
+				Params: map[string]interface{}{"p0": "typeOf", "p1": "State", "p2": "name"},
+				Header: []string{"?name"},
+			},
+			false,
+		},
+		{
+			`SELECT DISTINCT ?dcid ?name
+			WHERE {
+				?place name ?name .
+				?place dcid ("geoId/06" "geoId/08") .
+				?place dcid ?dcid
+			}
+			ORDER BY DESC(?name)
+			LIMIT 10`,
+			&TriplesQuery{
+				SQL: "SELECT DISTINCT t0.subject_id AS c0, t0.object_id AS c1 FROM triples t0 " +
+					"WHERE t0.predicate = :p0 AND t0.subject_id IN (:p1) ORDER BY c1 DESC LIMIT 10",
+				Params: map[string]interface{}{"p0": "name", "p1": []string{"geoId/06", "geoId/08"}},
+				Header: []string{"?dcid", "?name"},
+			},
+			false,
+		},
+		{
+			`SELECT ?name WHERE {?place dcid "geoId/06"}`,
+			nil,
+			true,
+		},
+		{
+			`SELECT ?name WHERE {?place typeOf State}`,
+			nil,
+			true,
+		},
+		{
+			`SELECT ?name WHERE {?place name ?name} ORDER BY ?place`,
+			nil,
+			true,
+		},
+	} {
+		result, err := TranslateToTriples(c.query, table)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("TranslateToTriples(%s) = nil, want error", c.query)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("TranslateToTriples(%s) = %s", c.query, err)
+			continue
+		}
+		if diff := deep.Equal(c.want, result); diff != nil {
+			t.Errorf("Unexpected diff %v", diff)
+		}
+	}
+}
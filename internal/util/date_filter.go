@@ -0,0 +1,201 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"regexp"
+	"strings"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// latestDate is the date of observation requests for the latest observation of each facet.
+const latestDate = "LATEST"
+
+// CommonLatestDate is the date of observation requests for the most recent date
+// that most entities have data for, so that the values of all entities are for the same date.
+const CommonLatestDate = "COMMON_LATEST"
+
+// isoDate matches the dates of date ranges: YYYY, YYYY-MM or YYYY-MM-DD.
+var isoDate = regexp.MustCompile(`^\d{4}(-\d{2}){0,2}$`)
+
+// DateFilter selects the observation dates of a request that data sources don't handle themselves.
+// Data sources handle empty dates, LATEST and single dates.
+type DateFilter struct {
+	// Bounds of an inclusive date range, empty if unbounded.
+	// Dates that start with End, e.g. 2020-05 for 2020, are in the range.
+	Start string
+	End   string
+	// Whether to select the most recent date that most entities have data for.
+	CommonLatest bool
+}
+
+// ParseDateFilter parses the date of an observation request if it is either:
+//   - a date range: "2010..2020", "2015.." or "..2020-06"
+//   - COMMON_LATEST
+//
+// It returns nil for other dates, which are handled by data sources.
+func ParseDateFilter(date string) (*DateFilter, error) {
+	date = strings.TrimSpace(date)
+	if date == CommonLatestDate {
+		return &DateFilter{CommonLatest: true}, nil
+	}
+	start, end, ok := strings.Cut(date, "..")
+	if !ok {
+		return nil, nil
+	}
+	start, end = strings.TrimSpace(start), strings.TrimSpace(end)
+	if (start == "" && end == "") ||
+		(start != "" && !isoDate.MatchString(start)) ||
+		(end != "" && !isoDate.MatchString(end)) ||
+		(start != "" && end != "" && start > end && !strings.HasPrefix(start, end)) {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid date range %q, want a range of ISO dates like 2010..2020 or 2015..", date)
+	}
+	return &DateFilter{Start: start, End: end}, nil
+}
+
+// Match returns whether a date is in the range of the filter. A nil filter matches all dates.
+// All dates match COMMON_LATEST filters, whose date depends on the other observations.
+func (f *DateFilter) Match(date string) bool {
+	if f == nil {
+		return true
+	}
+	if f.Start != "" && date < f.Start {
+		return false
+	}
+	if f.End != "" && date > f.End && !strings.HasPrefix(date, f.End) {
+		return false
+	}
+	return true
+}
+
+// FilterObservationsByDate removes the observations that don't match a date filter from a response,
+// the same way as FilterObservationsByValue.
+// For COMMON_LATEST filters, only the observations of the common latest date of each variable are kept.
+func FilterObservationsByDate(resp *pbv2.ObservationResponse, filter *DateFilter) {
+	if filter == nil {
+		return
+	}
+	if !filter.CommonLatest {
+		FilterObservations(resp, func(_ string, obs *pb.PointStat) bool {
+			return filter.Match(obs.GetDate())
+		})
+		return
+	}
+	dates := map[string]string{}
+	for variable, variableObs := range resp.GetByVariable() {
+		dates[variable] = commonLatestDate(variableObs)
+	}
+	FilterObservations(resp, func(variable string, obs *pb.PointStat) bool {
+		return obs.GetDate() == dates[variable]
+	})
+}
+
+// FilterObservationsByRequestDate keeps the observations of a response for the date of an observation request,
+// the same way as data sources do. It is used for responses computed from observations of all dates.
+func FilterObservationsByRequestDate(resp *pbv2.ObservationResponse, date string) error {
+	filter, err := ParseDateFilter(date)
+	if err != nil {
+		return err
+	}
+	switch {
+	case filter != nil:
+		FilterObservationsByDate(resp, filter)
+	case date == "":
+	case date == latestDate:
+		// Observations are sorted by date, so the latest observation of each facet is the last one.
+		for _, variableObs := range resp.GetByVariable() {
+			for _, entityObs := range variableObs.GetByEntity() {
+				for _, facetObs := range entityObs.GetOrderedFacets() {
+					if n := len(facetObs.GetObservations()); n > 1 {
+						facetObs.Observations = facetObs.Observations[n-1:]
+					}
+				}
+			}
+		}
+		FilterObservations(resp, func(string, *pb.PointStat) bool { return true })
+	default:
+		FilterObservations(resp, func(_ string, obs *pb.PointStat) bool {
+			return obs.GetDate() == date
+		})
+	}
+	return nil
+}
+
+// commonLatestDate returns the most recent date that more than half of the entities with observations
+// of a variable have data for. If there is no such date, it returns the date that the most entities
+// have data for, the most recent one in case of ties.
+func commonLatestDate(variableObs *pbv2.VariableObservation) string {
+	entityCount := 0
+	dateEntityCount := map[string]int{}
+	for _, entityObs := range variableObs.GetByEntity() {
+		dates := map[string]struct{}{}
+		for _, facetObs := range entityObs.GetOrderedFacets() {
+			for _, obs := range facetObs.GetObservations() {
+				dates[obs.GetDate()] = struct{}{}
+			}
+		}
+		if len(dates) > 0 {
+			entityCount++
+		}
+		for date := range dates {
+			dateEntityCount[date]++
+		}
+	}
+
+	var latest, mostCovered string
+	for date, count := range dateEntityCount {
+		if 2*count > entityCount && date > latest {
+			latest = date
+		}
+		if mostCovered == "" || count > dateEntityCount[mostCovered] ||
+			(count == dateEntityCount[mostCovered] && date > mostCovered) {
+			mostCovered = date
+		}
+	}
+	if latest != "" {
+		return latest
+	}
+	return mostCovered
+}
+
+// ObservationRequestForDateFilter returns the request to send to data sources for a request
+// whose date is filtered after fetching the observations. It requests all dates, and all values
+// so that values are filtered after dates, as for other dates.
+// It returns an error if the request doesn't select dates and values.
+func ObservationRequestForDateFilter(in *pbv2.ObservationRequest) (*pbv2.ObservationRequest, error) {
+	var selectDate, selectValue bool
+	for _, item := range in.GetSelect() {
+		switch item {
+		case "date":
+			selectDate = true
+		case "value":
+			selectValue = true
+		}
+	}
+	if !selectDate || !selectValue {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"date %q requires selecting 'date' and 'value'", in.GetDate())
+	}
+	out := proto.Clone(in).(*pbv2.ObservationRequest)
+	out.Date = ""
+	out.Value = ""
+	return out, nil
+}
@@ -0,0 +1,183 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestParseDateFilter(t *testing.T) {
+	for _, c := range []struct {
+		date    string
+		want    *DateFilter
+		match   []string
+		noMatch []string
+	}{
+		{"", nil, []string{"2020"}, nil},
+		{"LATEST", nil, nil, nil},
+		{"2020", nil, nil, nil},
+		{"COMMON_LATEST", &DateFilter{CommonLatest: true}, []string{"2020"}, nil},
+		{"2010..2020", &DateFilter{Start: "2010", End: "2020"}, []string{"2010", "2015-06", "2020", "2020-12-31"}, []string{"2009-12", "2021"}},
+		{"2015..", &DateFilter{Start: "2015"}, []string{"2015", "2030-01"}, []string{"2014-12-31"}},
+		{"..2020-06", &DateFilter{End: "2020-06"}, []string{"1990", "2020-06-30"}, []string{"2020-07"}},
+		{"2020-03..2020", &DateFilter{Start: "2020-03", End: "2020"}, []string{"2020-03", "2020-11"}, []string{"2020-02"}},
+	} {
+		got, err := ParseDateFilter(c.date)
+		if err != nil {
+			t.Fatalf("ParseDateFilter(%q) error: %v", c.date, err)
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("ParseDateFilter(%q) got diff %v", c.date, diff)
+		}
+		for _, date := range c.match {
+			if !got.Match(date) {
+				t.Errorf("ParseDateFilter(%q).Match(%s) = false, want true", c.date, date)
+			}
+		}
+		for _, date := range c.noMatch {
+			if got.Match(date) {
+				t.Errorf("ParseDateFilter(%q).Match(%s) = true, want false", c.date, date)
+			}
+		}
+	}
+
+	for _, date := range []string{"..", "2020..2010", "20..2020", "2010..latest", "2010-1..2020"} {
+		if _, err := ParseDateFilter(date); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ParseDateFilter(%q) error = %v, want InvalidArgument", date, err)
+		}
+	}
+}
+
+func TestFilterObservationsByDate(t *testing.T) {
+	// series returns the observations of an entity with a value for each date.
+	series := func(dates ...string) *pbv2.EntityObservation {
+		facetObs := &pbv2.FacetObservation{FacetId: "1"}
+		for _, date := range dates {
+			facetObs.Observations = append(facetObs.Observations, &pb.PointStat{Date: date, Value: proto.Float64(1)})
+		}
+		facetObs.ObsCount = int32(len(dates))
+		facetObs.EarliestDate = dates[0]
+		facetObs.LatestDate = dates[len(dates)-1]
+		return &pbv2.EntityObservation{OrderedFacets: []*pbv2.FacetObservation{facetObs}}
+	}
+	response := func(byEntity map[string]*pbv2.EntityObservation) *pbv2.ObservationResponse {
+		return &pbv2.ObservationResponse{
+			ByVariable: map[string]*pbv2.VariableObservation{"Count_Person": {ByEntity: byEntity}},
+			Facets:     map[string]*pb.Facet{"1": {ImportName: "CensusPEP"}},
+		}
+	}
+
+	for _, c := range []struct {
+		name string
+		date string
+		in   map[string]*pbv2.EntityObservation
+		want map[string]*pbv2.EntityObservation
+	}{
+		{
+			name: "range",
+			date: "2019..2020",
+			in:   map[string]*pbv2.EntityObservation{"geoId/01": series("2018", "2019", "2020-06", "2021")},
+			want: map[string]*pbv2.EntityObservation{"geoId/01": series("2019", "2020-06")},
+		},
+		{
+			name: "common latest date covered by most entities",
+			date: CommonLatestDate,
+			in: map[string]*pbv2.EntityObservation{
+				"geoId/01": series("2019", "2020", "2022"),
+				"geoId/02": series("2020", "2021"),
+				"geoId/03": series("2019", "2020"),
+				"geoId/04": {},
+			},
+			want: map[string]*pbv2.EntityObservation{
+				"geoId/01": series("2020"),
+				"geoId/02": series("2020"),
+				"geoId/03": series("2020"),
+				"geoId/04": {},
+			},
+		},
+		{
+			name: "common latest date covered by the most entities",
+			date: CommonLatestDate,
+			in: map[string]*pbv2.EntityObservation{
+				"geoId/01": series("2019", "2022"),
+				"geoId/02": series("2019", "2021"),
+				"geoId/03": series("2020", "2021"),
+				"geoId/04": series("2018"),
+			},
+			want: map[string]*pbv2.EntityObservation{
+				"geoId/01": {},
+				"geoId/02": series("2021"),
+				"geoId/03": series("2021"),
+				"geoId/04": {},
+			},
+		},
+	} {
+		filter, err := ParseDateFilter(c.date)
+		if err != nil {
+			t.Fatalf("ParseDateFilter(%q) error: %v", c.date, err)
+		}
+		got := response(c.in)
+		FilterObservationsByDate(got, filter)
+		if diff := cmp.Diff(got, response(c.want), protocmp.Transform()); diff != "" {
+			t.Errorf("FilterObservationsByDate (%s) got diff %v", c.name, diff)
+		}
+	}
+}
+
+func TestFilterObservationsByRequestDate(t *testing.T) {
+	response := func(dates ...string) *pbv2.ObservationResponse {
+		facetObs := &pbv2.FacetObservation{FacetId: "1"}
+		for _, date := range dates {
+			facetObs.Observations = append(facetObs.Observations, &pb.PointStat{Date: date, Value: proto.Float64(1)})
+		}
+		facetObs.ObsCount = int32(len(dates))
+		if len(dates) > 0 {
+			facetObs.EarliestDate = dates[0]
+			facetObs.LatestDate = dates[len(dates)-1]
+		}
+		return &pbv2.ObservationResponse{
+			ByVariable: map[string]*pbv2.VariableObservation{"Count_Person": {ByEntity: map[string]*pbv2.EntityObservation{
+				"geoId/06": {OrderedFacets: []*pbv2.FacetObservation{facetObs}},
+			}}},
+			Facets: map[string]*pb.Facet{"1": {ImportName: "CensusPEP"}},
+		}
+	}
+
+	for _, c := range []struct {
+		date string
+		want *pbv2.ObservationResponse
+	}{
+		{"", response("2019", "2020", "2021")},
+		{"LATEST", response("2021")},
+		{"2020", response("2020")},
+		{"2020..", response("2020", "2021")},
+	} {
+		got := response("2019", "2020", "2021")
+		if err := FilterObservationsByRequestDate(got, c.date); err != nil {
+			t.Fatalf("FilterObservationsByRequestDate(%q) error: %v", c.date, err)
+		}
+		if diff := cmp.Diff(got, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("FilterObservationsByRequestDate(%q) got diff %v", c.date, diff)
+		}
+	}
+}
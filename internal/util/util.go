@@ -532,6 +532,9 @@ func GetFacetID(m *pb.Facet) string {
 	if m.IsDcAggregate {
 		s += "-IsDcAggregate"
 	}
+	if m.Formula != "" {
+		s += "-" + m.Formula
+	}
 	h := fnv.New32a()
 	_, _ = h.Write([]byte(s))
 	return fmt.Sprint(h.Sum32())
@@ -665,7 +668,11 @@ func StringSetToSlice(s map[string]struct{}) []string {
 	return res
 }
 
-func FetchRemote(
+// FetchRemoteWithContext calls an API of the remote mixer.
+// The call is cancelled with the context, and the deadline of the context is sent to the remote mixer.
+// Errors are gRPC status errors with the code of the remote mixer's response.
+func FetchRemoteWithContext(
+	ctx context.Context,
 	metadata *resource.Metadata,
 	httpClient *http.Client,
 	apiPath string,
@@ -677,30 +684,114 @@ func FetchRemote(
 	if err != nil {
 		return err
 	}
-	request, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonValue))
+	request, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonValue))
 	if err != nil {
 		return err
 	}
 	request.Header.Set("Content-Type", "application/json")
 	request.Header.Set("X-API-Key", metadata.RemoteMixerAPIKey)
+	if deadline, ok := ctx.Deadline(); ok {
+		// Honored by the gRPC-JSON transcoder of the remote mixer.
+		request.Header.Set("Grpc-Timeout", encodeTimeout(time.Until(deadline)))
+	}
 	DCLog(DCLogRemoteMixerCall, fmt.Sprintf("url=%s", url))
 	response, err := httpClient.Do(request)
 	if err != nil {
-		return err
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return status.Errorf(codes.Unavailable, "error calling remote mixer: %v", err)
 	}
 	defer response.Body.Close()
 	// Read response body
-	var responseBodyBytes []byte
-	if response.StatusCode != http.StatusOK {
-		return fmt.Errorf("remote mixer response not ok: %s", response.Status)
-	}
-	responseBodyBytes, err = io.ReadAll(response.Body)
+	responseBodyBytes, err := io.ReadAll(response.Body)
 	if err != nil {
-		return err
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return status.Errorf(codes.Unavailable, "error reading remote mixer response: %v", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return remoteStatusError(response.StatusCode, response.Status, responseBodyBytes)
 	}
 	// Convert response body to string
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	return unmarshaler.Unmarshal(responseBodyBytes, out)
+	if err := unmarshaler.Unmarshal(responseBodyBytes, out); err != nil {
+		return status.Errorf(codes.Internal, "invalid remote mixer response: %v", err)
+	}
+	return nil
+}
+
+// remoteStatusError converts an error response of the remote mixer to a gRPC status error.
+// The gRPC-JSON transcoder of the remote mixer sends the status as {"code": 5, "message": "..."}.
+// Other responses, e.g. from load balancers, are mapped by their HTTP status.
+func remoteStatusError(httpStatus int, httpStatusText string, body []byte) error {
+	remoteStatus := struct {
+		Code    codes.Code `json:"code"`
+		Message string     `json:"message"`
+	}{}
+	if err := json.Unmarshal(body, &remoteStatus); err == nil &&
+		remoteStatus.Code > codes.OK && remoteStatus.Code <= codes.Unauthenticated {
+		return status.Errorf(remoteStatus.Code, "remote mixer response not ok: %s: %s", httpStatusText, remoteStatus.Message)
+	}
+	return status.Errorf(CodeFromHTTPStatus(httpStatus), "remote mixer response not ok: %s", httpStatusText)
+}
+
+// CodeFromHTTPStatus returns the gRPC code of an HTTP error status,
+// following https://github.com/googleapis/googleapis/blob/master/google/rpc/code.proto.
+func CodeFromHTTPStatus(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case 499: // Client closed request.
+		return codes.Canceled
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	switch {
+	case httpStatus >= 500:
+		return codes.Internal
+	case httpStatus >= 400:
+		return codes.FailedPrecondition
+	}
+	return codes.Unknown
+}
+
+// encodeTimeout encodes a timeout in the format of the grpc-timeout header, e.g. "1500m".
+func encodeTimeout(timeout time.Duration) string {
+	if timeout <= 0 {
+		return "1n"
+	}
+	// The value has at most 8 digits.
+	for _, unit := range []struct {
+		suffix   string
+		duration time.Duration
+	}{
+		{"n", time.Nanosecond},
+		{"u", time.Microsecond},
+		{"m", time.Millisecond},
+		{"S", time.Second},
+		{"M", time.Minute},
+	} {
+		if value := timeout / unit.duration; value < 1e8 {
+			return fmt.Sprintf("%d%s", value, unit.suffix)
+		}
+	}
+	return fmt.Sprintf("%d%s", timeout/time.Hour, "H")
 }
 
 // HasCollectionCache decides whether the Bigtable collection cache exists
@@ -15,13 +15,20 @@
 package util
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	v1 "github.com/datacommonsorg/mixer/internal/proto/v1"
 	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/datacommonsorg/mixer/internal/server/resource"
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/testing/protocmp"
 )
@@ -391,6 +398,14 @@ func TestGetFacetID(t *testing.T) {
 			},
 			"2985056674",
 		},
+		{
+			&pb.Facet{
+				ImportName:    "test_import",
+				IsDcAggregate: true,
+				Formula:       "Count_Person - Count_Person_Male",
+			},
+			"2486454408",
+		},
 	} {
 		got := GetFacetID(c.facet)
 		if !reflect.DeepEqual(got, c.want) {
@@ -435,3 +450,82 @@ func TestShouldIncludeFacet(t *testing.T) {
 		}
 	}
 }
+
+func TestFetchRemoteWithContext(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		httpStatus int
+		body       string
+		timeout    time.Duration
+		wantCode   codes.Code
+	}{
+		{
+			name:       "ok",
+			httpStatus: http.StatusOK,
+			body:       `{"data": {"geoId/06": {}}}`,
+			timeout:    time.Minute,
+			wantCode:   codes.OK,
+		},
+		{
+			name:       "remote status",
+			httpStatus: http.StatusBadRequest,
+			body:       `{"code": 3, "message": "invalid property"}`,
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			name:       "http status",
+			httpStatus: http.StatusServiceUnavailable,
+			body:       "upstream connect error",
+			wantCode:   codes.Unavailable,
+		},
+		{
+			name:       "unknown server error",
+			httpStatus: http.StatusInternalServerError,
+			wantCode:   codes.Internal,
+		},
+	} {
+		var gotTimeout string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotTimeout = r.Header.Get("Grpc-Timeout")
+			w.WriteHeader(tc.httpStatus)
+			_, _ = w.Write([]byte(tc.body))
+		}))
+		ctx, cancel := context.Background(), func() {}
+		if tc.timeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, tc.timeout)
+		}
+		err := FetchRemoteWithContext(ctx, &resource.Metadata{RemoteMixerDomain: server.URL}, server.Client(), "/v2/node", &pbv2.NodeRequest{}, &pbv2.NodeResponse{})
+		cancel()
+		server.Close()
+
+		if got := status.Code(err); got != tc.wantCode {
+			t.Errorf("FetchRemoteWithContext (%s) code = %v, want %v: %v", tc.name, got, tc.wantCode, err)
+		}
+		if (gotTimeout != "") != (tc.timeout > 0) {
+			t.Errorf("FetchRemoteWithContext (%s) sent grpc-timeout %q", tc.name, gotTimeout)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := FetchRemoteWithContext(ctx, &resource.Metadata{RemoteMixerDomain: "http://localhost:1"}, &http.Client{}, "/v2/node", &pbv2.NodeRequest{}, &pbv2.NodeResponse{})
+	if got := status.Code(err); got != codes.Canceled {
+		t.Errorf("FetchRemoteWithContext with a cancelled context code = %v, want Canceled", got)
+	}
+}
+
+func TestEncodeTimeout(t *testing.T) {
+	for _, c := range []struct {
+		timeout time.Duration
+		want    string
+	}{
+		{-time.Second, "1n"},
+		{50 * time.Millisecond, "50000000n"},
+		{1500 * time.Millisecond, "1500000u"},
+		{2 * time.Hour, "7200000m"},
+	} {
+		if got := encodeTimeout(c.timeout); got != c.want {
+			t.Errorf("encodeTimeout(%v) = %q, want %q", c.timeout, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ValueFilter is a predicate on observation values, from the value field of an observation request.
+// It matches the values in a range whose bounds are optional.
+type ValueFilter struct {
+	// Bounds of the range, nil if unbounded.
+	Min *float64
+	Max *float64
+	// Whether the bounds are excluded from the range.
+	MinExclusive bool
+	MaxExclusive bool
+}
+
+// ParseValueFilter parses the value filter of an observation request, which is either:
+//   - a comparison with a number: ">1000", ">=1000", "<0.5", "<=0.5" or "=3"
+//   - an inclusive range of numbers: "1000..5000"
+//
+// It returns nil if the filter is empty.
+func ParseValueFilter(expr string) (*ValueFilter, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, nil
+	}
+	invalid := func() (*ValueFilter, error) {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid value filter %q, want a comparison like >=1000 or a range like 1000..5000", expr)
+	}
+
+	if lo, hi, ok := strings.Cut(expr, ".."); ok {
+		min, err := parseFilterNumber(lo)
+		if err != nil {
+			return invalid()
+		}
+		max, err := parseFilterNumber(hi)
+		if err != nil || min > max {
+			return invalid()
+		}
+		return &ValueFilter{Min: &min, Max: &max}, nil
+	}
+
+	// Two-character operators are checked first.
+	for _, op := range []string{">=", "<=", "==", ">", "<", "="} {
+		if !strings.HasPrefix(expr, op) {
+			continue
+		}
+		value, err := parseFilterNumber(expr[len(op):])
+		if err != nil {
+			return invalid()
+		}
+		switch op {
+		case ">=":
+			return &ValueFilter{Min: &value}, nil
+		case ">":
+			return &ValueFilter{Min: &value, MinExclusive: true}, nil
+		case "<=":
+			return &ValueFilter{Max: &value}, nil
+		case "<":
+			return &ValueFilter{Max: &value, MaxExclusive: true}, nil
+		default:
+			return &ValueFilter{Min: &value, Max: &value}, nil
+		}
+	}
+	return invalid()
+}
+
+func parseFilterNumber(s string) (float64, error) {
+	value, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err == nil && (math.IsNaN(value) || math.IsInf(value, 0)) {
+		return 0, strconv.ErrSyntax
+	}
+	return value, err
+}
+
+// Match returns whether a value matches the filter. A nil filter matches all values.
+func (f *ValueFilter) Match(value float64) bool {
+	if f == nil {
+		return true
+	}
+	if f.Min != nil && (value < *f.Min || (f.MinExclusive && value == *f.Min)) {
+		return false
+	}
+	if f.Max != nil && (value > *f.Max || (f.MaxExclusive && value == *f.Max)) {
+		return false
+	}
+	return true
+}
+
+// FilterObservationsByValue removes the observations that don't match a value filter from a response.
+// Facets left without observations are removed, and the counts and dates of the others are updated.
+// Facets without observations to begin with, e.g. in responses to facet requests, are kept.
+func FilterObservationsByValue(resp *pbv2.ObservationResponse, filter *ValueFilter) {
+	if filter == nil {
+		return
+	}
+	FilterObservations(resp, func(_ string, obs *pb.PointStat) bool {
+		return obs.Value != nil && filter.Match(obs.GetValue())
+	})
+}
+
+// FilterObservations keeps the observations of a response for which keep returns true,
+// given the variable of the observation. See FilterObservationsByValue for the handling of facets.
+func FilterObservations(resp *pbv2.ObservationResponse, keep func(variable string, obs *pb.PointStat) bool) {
+	if resp == nil {
+		return
+	}
+	usedFacets := map[string]struct{}{}
+	for variable, variableObs := range resp.GetByVariable() {
+		for _, entityObs := range variableObs.GetByEntity() {
+			facets := entityObs.GetOrderedFacets()[:0]
+			for _, facetObs := range entityObs.GetOrderedFacets() {
+				if len(facetObs.GetObservations()) > 0 {
+					observations := facetObs.Observations[:0]
+					for _, obs := range facetObs.Observations {
+						if keep(variable, obs) {
+							observations = append(observations, obs)
+						}
+					}
+					if len(observations) == 0 {
+						continue
+					}
+					facetObs.Observations = observations
+					facetObs.ObsCount = int32(len(observations))
+					facetObs.EarliestDate = observations[0].GetDate()
+					facetObs.LatestDate = observations[len(observations)-1].GetDate()
+				}
+				facets = append(facets, facetObs)
+				usedFacets[facetObs.GetFacetId()] = struct{}{}
+			}
+			entityObs.OrderedFacets = facets
+		}
+	}
+	for facetID := range resp.GetFacets() {
+		if _, ok := usedFacets[facetID]; !ok {
+			delete(resp.Facets, facetID)
+		}
+	}
+}
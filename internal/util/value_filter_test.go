@@ -0,0 +1,141 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	pbv2 "github.com/datacommonsorg/mixer/internal/proto/v2"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestParseValueFilter(t *testing.T) {
+	for _, c := range []struct {
+		expr    string
+		want    *ValueFilter
+		match   []float64
+		noMatch []float64
+	}{
+		{"", nil, []float64{-1, 0, 1e9}, nil},
+		{">=1000", &ValueFilter{Min: proto.Float64(1000)}, []float64{1000, 1e9}, []float64{999.5}},
+		{"> 1000", &ValueFilter{Min: proto.Float64(1000), MinExclusive: true}, []float64{1001}, []float64{1000}},
+		{"<0.5", &ValueFilter{Max: proto.Float64(0.5), MaxExclusive: true}, []float64{-3, 0.4}, []float64{0.5}},
+		{"<=-2", &ValueFilter{Max: proto.Float64(-2)}, []float64{-2}, []float64{0}},
+		{"=3", &ValueFilter{Min: proto.Float64(3), Max: proto.Float64(3)}, []float64{3}, []float64{2, 4}},
+		{"1000..5000", &ValueFilter{Min: proto.Float64(1000), Max: proto.Float64(5000)}, []float64{1000, 5000}, []float64{999, 5001}},
+		{"-1e3..-10", &ValueFilter{Min: proto.Float64(-1000), Max: proto.Float64(-10)}, []float64{-500}, []float64{0}},
+	} {
+		got, err := ParseValueFilter(c.expr)
+		if err != nil {
+			t.Fatalf("ParseValueFilter(%q) error: %v", c.expr, err)
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("ParseValueFilter(%q) got diff %v", c.expr, diff)
+		}
+		for _, value := range c.match {
+			if !got.Match(value) {
+				t.Errorf("ParseValueFilter(%q).Match(%v) = false, want true", c.expr, value)
+			}
+		}
+		for _, value := range c.noMatch {
+			if got.Match(value) {
+				t.Errorf("ParseValueFilter(%q).Match(%v) = true, want false", c.expr, value)
+			}
+		}
+	}
+
+	for _, expr := range []string{"1000", ">", ">=abc", "5000..1000", "1..", "<NaN", ">=Inf", "=>3"} {
+		if _, err := ParseValueFilter(expr); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ParseValueFilter(%q) error = %v, want InvalidArgument", expr, err)
+		}
+	}
+}
+
+func TestFilterObservationsByValue(t *testing.T) {
+	resp := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{
+			"Count_Person": {ByEntity: map[string]*pbv2.EntityObservation{
+				"geoId/06": {OrderedFacets: []*pbv2.FacetObservation{
+					{
+						FacetId: "1",
+						Observations: []*pb.PointStat{
+							{Date: "2019", Value: proto.Float64(500)},
+							{Date: "2020", Value: proto.Float64(2000)},
+							{Date: "2021", Value: proto.Float64(3000)},
+							{Date: "2022", Value: proto.Float64(9000)},
+						},
+						ObsCount:     4,
+						EarliestDate: "2019",
+						LatestDate:   "2022",
+					},
+					{
+						FacetId:      "2",
+						Observations: []*pb.PointStat{{Date: "2020", Value: proto.Float64(10)}},
+						ObsCount:     1,
+						EarliestDate: "2020",
+						LatestDate:   "2020",
+					},
+				}},
+				"geoId/05": {OrderedFacets: []*pbv2.FacetObservation{
+					{FacetId: "3", ObsCount: 2, EarliestDate: "2010", LatestDate: "2020"},
+				}},
+			}},
+		},
+		Facets: map[string]*pb.Facet{
+			"1": {ImportName: "CensusPEP"},
+			"2": {ImportName: "CensusACS"},
+			"3": {ImportName: "OECD"},
+		},
+	}
+	want := &pbv2.ObservationResponse{
+		ByVariable: map[string]*pbv2.VariableObservation{
+			"Count_Person": {ByEntity: map[string]*pbv2.EntityObservation{
+				"geoId/06": {OrderedFacets: []*pbv2.FacetObservation{
+					{
+						FacetId: "1",
+						Observations: []*pb.PointStat{
+							{Date: "2020", Value: proto.Float64(2000)},
+							{Date: "2021", Value: proto.Float64(3000)},
+						},
+						ObsCount:     2,
+						EarliestDate: "2020",
+						LatestDate:   "2021",
+					},
+				}},
+				"geoId/05": {OrderedFacets: []*pbv2.FacetObservation{
+					{FacetId: "3", ObsCount: 2, EarliestDate: "2010", LatestDate: "2020"},
+				}},
+			}},
+		},
+		Facets: map[string]*pb.Facet{
+			"1": {ImportName: "CensusPEP"},
+			"3": {ImportName: "OECD"},
+		},
+	}
+
+	filter, err := ParseValueFilter("1000..5000")
+	if err != nil {
+		t.Fatalf("ParseValueFilter error: %v", err)
+	}
+	FilterObservationsByValue(resp, filter)
+	if diff := cmp.Diff(resp, want, protocmp.Transform()); diff != "" {
+		t.Errorf("FilterObservationsByValue got diff %v", diff)
+	}
+}
@@ -29,6 +29,7 @@ import (
 	_ "modernc.org/sqlite" // import the sqlite driver
 
 	"cloud.google.com/go/bigquery"
+	"github.com/datacommonsorg/mixer/internal/merger"
 	pbs "github.com/datacommonsorg/mixer/internal/proto/service"
 	"github.com/datacommonsorg/mixer/internal/server"
 	"github.com/datacommonsorg/mixer/internal/server/cache"
@@ -171,7 +172,11 @@ func setupInternal(
 			log.Fatalf("SQL database validation failed: %v", err)
 		}
 		if enableV3 {
-			var ds datasource.DataSource = sqldb.NewSQLDataSource(&sqlClient, spannerDataSource)
+			sqlDataSource, err := sqldb.NewSQLDataSource(&sqlClient, spannerDataSource)
+			if err != nil {
+				log.Fatalf("Failed to create SQL data source: %v", err)
+			}
+			var ds datasource.DataSource = sqlDataSource
 			sources = append(sources, &ds)
 		}
 	}
@@ -209,11 +214,11 @@ func setupInternal(
 		sources = append(sources, &ds)
 	}
 
-	dataSources := datasources.NewDataSources(sources)
+	dataSources := datasources.NewDataSources(sources, nil, merger.MergePolicyUnion, nil)
 	// Processors
 	processors := []*dispatcher.Processor{}
 	if enableV3 {
-		var calculationProcessor dispatcher.Processor = observation.NewCalculationProcessor(dataSources, c.SVFormula())
+		var calculationProcessor dispatcher.Processor = observation.NewCalculationProcessor(dataSources, c.SVFormula(), nil)
 		processors = append(processors, &calculationProcessor)
 	}
 